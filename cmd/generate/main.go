@@ -2,18 +2,97 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"time"
 
-	"github.com/intervention-engine/fhir/upload"
-	"github.com/intervention-engine/ptgen"
+	"github.com/intervention-engine/tools/ptgen"
 )
 
 func main() {
 	registerURL := flag.String("fhirURL", "", "URL for the FHIR server")
 	num := flag.Int("n", 100, "Number of patients to generate")
+	seed := flag.Int64("seed", 0, "Seed for the random number generator, for reproducible patients (defaults to the current time)")
+	asOfDate := flag.String("asOf", "", "Date (YYYY-MM-DD) the patients are generated as of; no dates are generated after it (defaults to today)")
+	outDir := flag.String("out", "", "Path to a directory to write the patients to, instead of uploading them to a FHIR server")
+	format := flag.String("format", "bundle", "Format of the patients written to the out directory: bundle (one transaction bundle per patient) or ndjson (one file per resource type)")
+	conditionsPath := flag.String("conditions", "", "Path to a JSON condition catalog, or a directory containing conditions.json (defaults to the built-in catalog)")
+	medicationsPath := flag.String("medications", "", "Path to a JSON medication catalog, or a directory containing medications.json (defaults to the built-in catalog)")
+	codeSystems := flag.String("codeSystems", strings.Join(ptgen.CodeSystemNames, ","), "Comma separated code systems to code conditions with: "+strings.Join(ptgen.CodeSystemNames, ", "))
+	workers := flag.Int("workers", runtime.NumCPU(), "Number of patients to generate and write concurrently")
+	profilePath := flag.String("profile", "", "Path to a JSON population profile to generate patients from; YAML isn't supported (defaults to the built-in geriatric profile)")
+	metric := flag.Bool("metric", false, "Record weights, heights, blood sugars and lipids in kilograms, centimeters and mmol/L instead of pounds, inches and mg/dL")
+	clinics := flag.Int("clinics", 5, "Number of clinics in the provider directory, or 0 to generate patients without providers")
+	hospitals := flag.Int("hospitals", 2, "Number of hospitals in the provider directory")
+	practitioners := flag.Int("practitioners", 4, "Number of practitioners at each clinic and hospital in the provider directory")
+	riskAssessments := flag.Bool("riskAssessments", false, "Include each patient's expected risk scores as RiskAssessment resources")
+	riskLabelsDir := flag.String("riskLabels", "", "Path to a directory to write each patient's expected risk scores to, as a JSON file named for the patient's ID")
 	flag.Parse()
-	count := *num
-	for i := 0; i < count; i++ {
-		resources := ptgen.GeneratePatient()
-		upload.UploadResources(resources, *registerURL)
+
+	if *registerURL == "" && *outDir == "" {
+		panic("Must provide a parameter value for fhirURL or out")
+	}
+	if *workers < 1 {
+		panic("Must use at least one worker")
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+	asOf := ptgen.Today()
+	if *asOfDate != "" {
+		var err error
+		if asOf, err = time.Parse("2006-01-02", *asOfDate); err != nil {
+			panic("Couldn't parse the as-of date: " + err.Error())
+		}
+	}
+
+	catalog, err := ptgen.LoadCatalog(*conditionsPath, *medicationsPath, strings.Split(*codeSystems, ","))
+	if err != nil {
+		panic("Couldn't load catalog: " + err.Error())
+	}
+	profile := ptgen.DefaultProfile()
+	if *profilePath != "" {
+		if profile, err = ptgen.LoadProfile(*profilePath, catalog.Conditions); err != nil {
+			panic("Couldn't load profile: " + err.Error())
+		}
+	}
+	if *metric {
+		profile.MetricUnits = true
+	}
+
+	if *riskLabelsDir != "" {
+		if err := os.MkdirAll(*riskLabelsDir, 0755); err != nil {
+			panic("Couldn't create the risk labels directory: " + err.Error())
+		}
+	}
+
+	w, err := newPatientWriter(*registerURL, *outDir, *format)
+	if err != nil {
+		panic("Couldn't set up output: " + err.Error())
+	}
+
+	// The directory is drawn from the run's seed, and the patients from a seed
+	// drawn after it
+	r := ptgen.NewRand(*seed)
+	var dir *ptgen.Directory
+	if *clinics > 0 {
+		if dir, err = ptgen.GenerateDirectory(r, profile.Places(), *clinics, *hospitals, *practitioners); err != nil {
+			panic("Couldn't generate the provider directory: " + err.Error())
+		}
+		if err := w.WriteDirectory(dir.Resources()); err != nil {
+			panic("Couldn't write the provider directory: " + err.Error())
+		}
+	}
+
+	summary := generatePatients(*num, *workers, r.Int63(), asOf, profile, catalog, dir, w, riskOutput{assessments: *riskAssessments, labelsDir: *riskLabelsDir}, os.Stderr, 5*time.Second)
+	if err := w.Close(); err != nil {
+		fmt.Println("Couldn't finish writing patients: " + err.Error())
+		os.Exit(1)
+	}
+	summary.report(os.Stdout)
+	if len(summary.failures) > 0 {
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,278 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/intervention-engine/fhir/models"
+	"github.com/intervention-engine/tools/upload"
+	"github.com/satori/go.uuid"
+)
+
+// patientWriter handles the resources generated for each patient, either by
+// uploading them to a FHIR server or by writing them to disk.  The provider
+// directory is written first, by WriteDirectory, which assigns the directory
+// resources the IDs that patients will reference them by.  WritePatient may be
+// called concurrently, and is given the index of the patient in the run (from
+// 0), which writers that keep patients in order use to order them.
+type patientWriter interface {
+	WriteDirectory(resources []interface{}) error
+	WritePatient(index int, resources []interface{}) error
+	Close() error
+}
+
+// newPatientWriter returns the patientWriter for the requested output.  If an
+// output directory is given, resources are written to it in the given format,
+// otherwise they are uploaded to the FHIR server.
+func newPatientWriter(fhirURL, outDir, format string) (patientWriter, error) {
+	if outDir == "" {
+		return &uploader{baseURL: fhirURL}, nil
+	}
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return nil, err
+	}
+	switch format {
+	case "bundle":
+		return &bundleWriter{dir: outDir}, nil
+	case "ndjson":
+		return &ndjsonWriter{dir: outDir, files: make(map[string]*os.File), pending: make(map[int][]ndjsonLine)}, nil
+	}
+	return nil, fmt.Errorf("Unsupported output format: %s", format)
+}
+
+// uploader uploads each patient's resources to a FHIR server
+type uploader struct {
+	baseURL string
+}
+
+// WriteDirectory uploads the directory's resources, replacing the references
+// between them (including those nested in practitioners' roles) with their
+// server IDs.  Patients then reference the directory by its server IDs.
+func (u *uploader) WriteDirectory(resources []interface{}) error {
+	return upload.UploadResources(resources, u.baseURL, make(map[string]string))
+}
+
+// WritePatient uploads the patient's resources, replacing the references
+// between them (including nested ones, such as an inpatient stay's admitting
+// diagnoses) with their server IDs.  Since the patient's resources are only
+// referenced by each other, each patient's references are resolved on their
+// own.
+func (u *uploader) WritePatient(index int, resources []interface{}) error {
+	return upload.UploadResources(resources, u.baseURL, make(map[string]string))
+}
+
+func (u *uploader) Close() error {
+	return nil
+}
+
+// bundleWriter writes each patient to its own file as a FHIR transaction
+// bundle, which can later be posted to a FHIR server using uploadfhir.  The
+// provider directory is written to directory.json as a bundle that puts each
+// resource at its assigned ID, so that patient bundles can reference them.
+type bundleWriter struct {
+	dir string
+}
+
+func (b *bundleWriter) WriteDirectory(resources []interface{}) error {
+	targets := assignIDs(resources)
+	bundle := &models.Bundle{Type: "transaction"}
+	bundle.Entry = make([]models.BundleEntryComponent, len(resources))
+	for i, resource := range resources {
+		rewriteReferences(resource, targets, func(target interface{}) string {
+			return resourceType(target) + "/" + resourceID(target)
+		})
+		bundle.Entry[i].Resource = resource
+		bundle.Entry[i].Request = &models.BundleEntryRequestComponent{
+			Method: "PUT",
+			Url:    resourceType(resource) + "/" + resourceID(resource),
+		}
+	}
+	return b.writeBundle(bundle, directoryFile)
+}
+
+func (b *bundleWriter) WritePatient(index int, resources []interface{}) error {
+	targets := assignIDs(resources)
+	bundle := &models.Bundle{Type: "transaction"}
+	bundle.Entry = make([]models.BundleEntryComponent, len(resources))
+	for i, resource := range resources {
+		rewriteReferences(resource, targets, func(target interface{}) string {
+			return "urn:uuid:" + resourceID(target)
+		})
+		bundle.Entry[i].FullUrl = "urn:uuid:" + resourceID(resource)
+		bundle.Entry[i].Resource = resource
+		bundle.Entry[i].Request = &models.BundleEntryRequestComponent{
+			Method: "POST",
+			Url:    resourceType(resource),
+		}
+	}
+
+	return b.writeBundle(bundle, resourceID(resources[0])+".json")
+}
+
+// directoryFile is the name of the bundle the provider directory is written
+// to.  uploadfhir uploads it before the patient bundles that reference it.
+const directoryFile = "directory.json"
+
+func (b *bundleWriter) writeBundle(bundle *models.Bundle, name string) error {
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(b.dir, name), data, 0644)
+}
+
+func (b *bundleWriter) Close() error {
+	return nil
+}
+
+// ndjsonWriter appends each resource as a single line to a newline delimited
+// JSON file named for its resource type (e.g., Patient.ndjson).  Patients are
+// written in the order of their index, whatever order they are generated in,
+// so seeded runs produce identical files no matter how many workers generate
+// them.  Patients generated ahead of their turn are held until the patients
+// before them have been written.
+type ndjsonWriter struct {
+	dir     string
+	files   map[string]*os.File
+	next    int
+	pending map[int][]ndjsonLine
+	mutex   sync.Mutex
+}
+
+// ndjsonLine is a resource encoded as a line of the file for its type
+type ndjsonLine struct {
+	rType string
+	data  []byte
+}
+
+// WriteDirectory appends the directory's resources to the files for their
+// types, before any patient's resources
+func (n *ndjsonWriter) WriteDirectory(resources []interface{}) error {
+	lines, err := n.encode(resources)
+	if err != nil {
+		return err
+	}
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.writeLines(lines)
+}
+
+// WritePatient encodes the patient's resources, and writes them along with
+// any held patients that follow them, once every patient before them has
+// been written.  A patient that can't be encoded is skipped, so it doesn't
+// hold up the patients after it.  Errors writing the files are returned to
+// the caller whose patient completes the run of patients being written.
+func (n *ndjsonWriter) WritePatient(index int, resources []interface{}) error {
+	lines, err := n.encode(resources)
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.pending[index] = lines
+	for {
+		lines, ok := n.pending[n.next]
+		if !ok {
+			return err
+		}
+		delete(n.pending, n.next)
+		n.next++
+		if werr := n.writeLines(lines); werr != nil && err == nil {
+			err = werr
+		}
+	}
+}
+
+// encode assigns the resources UUIDs and encodes them as lines, with their
+// references to each other rewritten to the UUIDs
+func (n *ndjsonWriter) encode(resources []interface{}) ([]ndjsonLine, error) {
+	targets := assignIDs(resources)
+	lines := make([]ndjsonLine, len(resources))
+	for i, resource := range resources {
+		rewriteReferences(resource, targets, func(target interface{}) string {
+			return resourceType(target) + "/" + resourceID(target)
+		})
+		data, err := json.Marshal(resource)
+		if err != nil {
+			return nil, err
+		}
+		lines[i] = ndjsonLine{rType: resourceType(resource), data: append(data, '\n')}
+	}
+	return lines, nil
+}
+
+func (n *ndjsonWriter) writeLines(lines []ndjsonLine) error {
+	for _, line := range lines {
+		f, err := n.file(line.rType)
+		if err != nil {
+			return err
+		}
+		if _, err := f.Write(line.data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *ndjsonWriter) file(rType string) (*os.File, error) {
+	if f, ok := n.files[rType]; ok {
+		return f, nil
+	}
+	f, err := os.Create(filepath.Join(n.dir, rType+".ndjson"))
+	if err != nil {
+		return nil, err
+	}
+	n.files[rType] = f
+	return f, nil
+}
+
+func (n *ndjsonWriter) Close() error {
+	var err error
+	for _, f := range n.files {
+		if cerr := f.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// assignIDs replaces the generated ID on each resource with a UUID and
+// returns a map from the old IDs to the resources they identified.  The UUIDs
+// are derived from the generated ID of the first resource (the patient, or the
+// directory's first organization), so seeded runs produce identical output.
+func assignIDs(resources []interface{}) map[string]interface{} {
+	targets := make(map[string]interface{})
+	ptID := resourceID(resources[0])
+	for i, resource := range resources {
+		if oldID := resourceID(resource); oldID != "" {
+			targets[oldID] = resource
+		}
+		newID := uuid.NewV5(uuid.NamespaceOID, fmt.Sprintf("%s/%d", ptID, i)).String()
+		reflect.ValueOf(resource).Elem().FieldByName("Id").SetString(newID)
+	}
+	return targets
+}
+
+// rewriteReferences walks the resource and replaces each "cid:" reference
+// with a reference to the target resource, as formatted by refFn.
+func rewriteReferences(resource interface{}, targets map[string]interface{}, refFn func(target interface{}) string) {
+	upload.WalkReferences(resource, func(ref *models.Reference) {
+		if !strings.HasPrefix(ref.Reference, "cid:") {
+			return
+		}
+		if target, ok := targets[strings.TrimPrefix(ref.Reference, "cid:")]; ok {
+			ref.Reference = refFn(target)
+		}
+	})
+}
+
+func resourceID(resource interface{}) string {
+	return reflect.ValueOf(resource).Elem().FieldByName("Id").String()
+}
+
+func resourceType(resource interface{}) string {
+	return reflect.TypeOf(resource).Elem().Name()
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/intervention-engine/tools/ptgen"
+)
+
+// generateNDJSON generates patients with the given number of workers as NDJSON
+// files in a temporary directory, and returns the contents of each file
+func generateNDJSON(t *testing.T, workers int) map[string][]byte {
+	out, err := ioutil.TempDir("", "ndjson")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(out)
+	w, err := newPatientWriter("", out, "ndjson")
+	if err != nil {
+		t.Fatal(err)
+	}
+	asOf := time.Date(2016, time.June, 1, 0, 0, 0, 0, time.UTC)
+	summary := generatePatients(40, workers, 42, asOf, ptgen.DefaultProfile(), ptgen.DefaultCatalog(), nil, w, riskOutput{assessments: true}, ioutil.Discard, time.Hour)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(summary.failures) > 0 {
+		t.Fatalf("Couldn't write %d patients: %v", len(summary.failures), summary.failures[0].err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(out, "*.ndjson"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	contents := make(map[string][]byte)
+	for _, file := range files {
+		if contents[filepath.Base(file)], err = ioutil.ReadFile(file); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return contents
+}
+
+func TestNDJSONIsIdenticalForAnyNumberOfWorkers(t *testing.T) {
+	serial := generateNDJSON(t, 1)
+	concurrent := generateNDJSON(t, 8)
+	if len(serial) == 0 || len(serial) != len(concurrent) {
+		t.Fatalf("Expected the same files, got %d and %d", len(serial), len(concurrent))
+	}
+	for name, data := range serial {
+		if !bytes.Equal(data, concurrent[name]) {
+			t.Errorf("%s differs between 1 and 8 workers", name)
+		}
+	}
+}
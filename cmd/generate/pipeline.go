@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/intervention-engine/tools/ptgen"
+)
+
+// patientJob is a single patient to generate.  Each patient is generated from
+// its own seed, drawn in order from the run's seed, so a seeded run produces
+// the same patients no matter how many workers generate them.
+type patientJob struct {
+	index int
+	seed  int64
+}
+
+// patientResult is the outcome of generating and writing a single patient
+type patientResult struct {
+	index int
+	err   error
+}
+
+// generateSummary describes the outcome of a run
+type generateSummary struct {
+	generated int
+	failures  []patientResult
+	elapsed   time.Duration
+}
+
+// generatePatients generates count patients using the given number of workers,
+// each of which writes its patients, generated as of the given date, with w,
+// along with their expected risk scores as requested.  Patients are assigned
+// to the directory's providers, if a directory is given.  Progress and
+// throughput are reported to progress every interval.
+func generatePatients(count, workers int, seed int64, asOf time.Time, profile *ptgen.Profile, catalog *ptgen.Catalog, dir *ptgen.Directory, w patientWriter, risks riskOutput, progress io.Writer, interval time.Duration) generateSummary {
+	start := time.Now()
+	jobs := make(chan patientJob)
+	results := make(chan patientResult)
+	done := make(chan struct{})
+
+	go func() {
+		r := ptgen.NewRand(seed)
+		for i := 0; i < count; i++ {
+			jobs <- patientJob{index: i, seed: r.Int63()}
+		}
+		close(jobs)
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				results <- patientResult{index: job.index, err: generatePatient(job, asOf, profile, catalog, dir, w, risks)}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	summary := generateSummary{}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case result := <-results:
+			summary.generated++
+			if result.err != nil {
+				summary.failures = append(summary.failures, result)
+			}
+		case <-ticker.C:
+			reportProgress(progress, summary, count, time.Since(start))
+		case <-done:
+			summary.elapsed = time.Since(start)
+			sort.Sort(byIndex(summary.failures))
+			return summary
+		}
+	}
+}
+
+// generatePatient generates the job's patient and writes it with w, along with
+// its expected risk scores as requested.  A panic while generating or writing
+// the patient is returned as its error, so that it fails only that patient.
+func generatePatient(job patientJob, asOf time.Time, profile *ptgen.Profile, catalog *ptgen.Catalog, dir *ptgen.Directory, w patientWriter, risks riskOutput) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	resources, scores := ptgen.GeneratePatientWithRisks(ptgen.NewRand(job.seed), profile, catalog, dir, asOf)
+	return risks.writePatient(w, job.index, resources, scores)
+}
+
+func reportProgress(progress io.Writer, summary generateSummary, count int, elapsed time.Duration) {
+	fmt.Fprintf(progress, "Generated %d/%d patients (%.1f patients/s, %d failed)\n",
+		summary.generated, count, throughput(summary.generated, elapsed), len(summary.failures))
+}
+
+// report writes the final summary of the run, including the error for each
+// patient that couldn't be written
+func (s generateSummary) report(out io.Writer) {
+	fmt.Fprintf(out, "Generated %d patients in %s (%.1f patients/s)\n", s.generated, s.elapsed, throughput(s.generated, s.elapsed))
+	if len(s.failures) == 0 {
+		return
+	}
+	fmt.Fprintf(out, "Couldn't write %d patients:\n", len(s.failures))
+	for _, f := range s.failures {
+		fmt.Fprintf(out, "  patient %d: %s\n", f.index+1, f.err)
+	}
+}
+
+type byIndex []patientResult
+
+func (b byIndex) Len() int           { return len(b) }
+func (b byIndex) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byIndex) Less(i, j int) bool { return b[i].index < b[j].index }
+
+func throughput(patients int, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(patients) / elapsed.Seconds()
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/intervention-engine/tools/ptgen"
+)
+
+// failingWriter fails to write the patients at some indexes, either by
+// returning an error or by panicking, and discards the rest
+type failingWriter struct {
+	errs   map[int]bool
+	panics map[int]bool
+}
+
+func (w failingWriter) WriteDirectory(resources []interface{}) error {
+	return nil
+}
+
+func (w failingWriter) WritePatient(index int, resources []interface{}) error {
+	if w.panics[index] {
+		panic("writer exploded")
+	}
+	if w.errs[index] {
+		return errors.New("server unavailable")
+	}
+	return nil
+}
+
+func (w failingWriter) Close() error {
+	return nil
+}
+
+func TestSummaryListsFailedPatients(t *testing.T) {
+	w := failingWriter{errs: map[int]bool{2: true}, panics: map[int]bool{5: true}}
+	asOf := time.Date(2016, time.June, 1, 0, 0, 0, 0, time.UTC)
+	summary := generatePatients(8, 3, 42, asOf, ptgen.DefaultProfile(), ptgen.DefaultCatalog(), nil, w, riskOutput{}, ioutil.Discard, time.Hour)
+	if summary.generated != 8 {
+		t.Errorf("Expected all 8 patients to be attempted, got %d", summary.generated)
+	}
+	if len(summary.failures) != 2 || summary.failures[0].index != 2 || summary.failures[1].index != 5 {
+		t.Fatalf("Expected patients 2 and 5 to fail, got %v", summary.failures)
+	}
+
+	var out bytes.Buffer
+	summary.report(&out)
+	for _, expected := range []string{"Couldn't write 2 patients", "patient 3: server unavailable", "patient 6: panic: writer exploded"} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("Expected the summary to contain %q, got:\n%s", expected, out.String())
+		}
+	}
+}
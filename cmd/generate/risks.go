@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/intervention-engine/tools/ptgen"
+)
+
+// riskOutput describes how the expected risk scores of each patient are
+// output: as RiskAssessment resources among the patient's resources, and/or as
+// a JSON file per patient in a labels directory
+type riskOutput struct {
+	assessments bool
+	labelsDir   string
+}
+
+// riskLabels is the content of a patient's risk labels file
+type riskLabels struct {
+	Patient string            `json:"patient"`
+	Scores  []ptgen.RiskScore `json:"scores"`
+}
+
+// writePatient writes the patient at the given index in the run with w, along
+// with the patient's expected risk scores.  Only the scores of models that
+// apply to the patient are included as risk assessments.
+func (o riskOutput) writePatient(w patientWriter, index int, resources []interface{}, scores []ptgen.RiskScore) error {
+	ptID := resourceID(resources[0])
+	if o.assessments {
+		for _, s := range scores {
+			if !s.Applicable {
+				continue
+			}
+			resources = append(resources, s.RiskAssessment(ptID))
+		}
+	}
+	// Remember the resources the scores are based on, since writing the
+	// patient replaces their generated IDs
+	targets := make(map[string]interface{})
+	for _, resource := range resources {
+		if id := resourceID(resource); id != "" {
+			targets[id] = resource
+		}
+	}
+	if err := w.WritePatient(index, resources); err != nil {
+		return err
+	}
+	if o.labelsDir == "" {
+		return nil
+	}
+
+	labels := riskLabels{Patient: resourceID(resources[0]), Scores: scores}
+	for i := range labels.Scores {
+		basis := make([]string, len(labels.Scores[i].Basis))
+		for j, id := range labels.Scores[i].Basis {
+			basis[j] = resourceID(targets[id])
+		}
+		labels.Scores[i].Basis = basis
+	}
+	data, err := json.MarshalIndent(labels, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(o.labelsDir, labels.Patient+".json"), data, 0644)
+}
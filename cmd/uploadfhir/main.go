@@ -1,35 +1,79 @@
 package main
 
 import (
+	"bufio"
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/intervention-engine/fhir/models"
+	"github.com/intervention-engine/tools/upload"
 )
 
 func main() {
 	fhirURL := flag.String("fhirURL", "", "URL for the FHIR server")
 	single := flag.String("bundle", "", "Path to the a single JSON file containing a bundle to upload")
+	dir := flag.String("dir", "", "Path to a directory of JSON files, each containing a bundle to upload, and/or NDJSON files, each containing one resource per line")
 	flag.Parse()
 
-	if *fhirURL == "" || *single == "" {
-		panic("Must provide parameter values for fhirURL and bundle")
+	if *fhirURL == "" || (*single == "" && *dir == "") {
+		panic("Must provide parameter values for fhirURL and bundle or dir")
 	}
 
+	var fileNames, ndjsonFileNames []string
+	if *dir != "" {
+		files, err := ioutil.ReadDir(*dir)
+		if err != nil {
+			panic("Couldn't read the directory: " + err.Error())
+		}
+		for _, file := range files {
+			if !file.IsDir() && strings.HasSuffix(file.Name(), ".ndjson") {
+				ndjsonFileNames = append(ndjsonFileNames, filepath.Join(*dir, file.Name()))
+				continue
+			}
+			if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+				continue
+			}
+			// The provider directory written by generate must be uploaded
+			// before the patients that reference it
+			if file.Name() == "directory.json" {
+				fileNames = append([]string{filepath.Join(*dir, file.Name())}, fileNames...)
+			} else {
+				fileNames = append(fileNames, filepath.Join(*dir, file.Name()))
+			}
+		}
+	} else {
+		fileNames = []string{*single}
+	}
+
+	for _, fileName := range fileNames {
+		uploadBundle(*fhirURL, fileName)
+	}
+	if len(ndjsonFileNames) > 0 {
+		uploadNDJSON(*fhirURL, ndjsonFileNames)
+	}
+}
+
+func uploadBundle(fhirURL, fileName string) {
 	// Read in the data in FHIR bundle format
-	data, err := os.Open(*single)
+	data, err := os.Open(fileName)
 	if err != nil {
 		panic("Couldn't read the JSON file: " + err.Error())
 	}
+	defer data.Close()
 
 	// Post the data
-	res, err := http.Post(*fhirURL+"/", "application/json", data)
+	res, err := http.Post(fhirURL+"/", "application/json", data)
 	if err != nil {
 		panic("Couldn't upload FHIR bundle: " + err.Error())
 	}
+	defer res.Body.Close()
 
 	decoder := json.NewDecoder(res.Body)
 	responseBundle := &models.Bundle{}
@@ -39,3 +83,100 @@ func main() {
 	}
 	fmt.Printf("Successfully uploaded %d resources\n", *responseBundle.Total)
 }
+
+// ndjsonUploadOrder is the order NDJSON files are uploaded in, named for the
+// resource type they contain, so that every resource is uploaded after the
+// resources it references.  Files for other resource types are uploaded
+// afterward, in order of their names.
+var ndjsonUploadOrder = []string{
+	"Organization", "Location", "Practitioner", "Patient", "RelatedPerson",
+	"FamilyMemberHistory", "AllergyIntolerance", "Immunization", "Condition",
+	"Procedure", "Encounter", "Observation", "DiagnosticReport",
+	"MedicationStatement", "MedicationOrder", "MedicationDispense",
+	"RiskAssessment",
+}
+
+// uploadNDJSON uploads the resources in the NDJSON files, each of which
+// contains one resource per line and is named for their type (e.g.,
+// Patient.ndjson), as written by generate.  Resources are uploaded one at a
+// time, with their references to resources uploaded before them replaced with
+// the IDs the server assigned those resources.
+func uploadNDJSON(fhirURL string, fileNames []string) {
+	byType := make(map[string]string)
+	types := make(map[string]bool)
+	var others []string
+	for _, fileName := range fileNames {
+		rType := ndjsonResourceType(fileName)
+		if models.StructForResourceName(rType) == nil {
+			panic("Couldn't determine the resource type of " + fileName)
+		}
+		byType[rType] = fileName
+		types[rType] = true
+		others = append(others, rType)
+	}
+	var ordered []string
+	for _, rType := range ndjsonUploadOrder {
+		if fileName, ok := byType[rType]; ok {
+			ordered = append(ordered, fileName)
+			delete(byType, rType)
+		}
+	}
+	sort.Strings(others)
+	for _, rType := range others {
+		if fileName, ok := byType[rType]; ok {
+			ordered = append(ordered, fileName)
+		}
+	}
+
+	refs := make(map[string]string)
+	for _, fileName := range ordered {
+		count := uploadNDJSONFile(fhirURL, fileName, refs, types)
+		fmt.Printf("Successfully uploaded %d resources from %s\n", count, filepath.Base(fileName))
+	}
+}
+
+// uploadNDJSONFile uploads the resources in the NDJSON file, replacing their
+// references using refs, and returns the number of resources uploaded.  A
+// reference to a resource of one of the given types that hasn't been uploaded
+// yet would be left pointing nowhere, so it is an error.
+func uploadNDJSONFile(fhirURL, fileName string, refs map[string]string, types map[string]bool) int {
+	f, err := os.Open(fileName)
+	if err != nil {
+		panic("Couldn't read the NDJSON file: " + err.Error())
+	}
+	defer f.Close()
+
+	rType := ndjsonResourceType(fileName)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	count := 0
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		resource := models.NewStructForResourceName(rType)
+		if err := json.Unmarshal(scanner.Bytes(), resource); err != nil {
+			panic(fmt.Sprintf("Couldn't parse %s line %d: %s", fileName, line, err))
+		}
+		upload.WalkReferences(resource, func(ref *models.Reference) {
+			parts := strings.SplitN(ref.Reference, "/", 2)
+			if _, ok := refs[ref.Reference]; !ok && len(parts) == 2 && types[parts[0]] {
+				panic(fmt.Sprintf("%s line %d references %s, which hasn't been uploaded", fileName, line, ref.Reference))
+			}
+		})
+		if err := upload.UploadResources([]interface{}{resource}, fhirURL, refs); err != nil {
+			panic(fmt.Sprintf("Couldn't upload %s line %d: %s", fileName, line, err))
+		}
+		count++
+	}
+	if err := scanner.Err(); err != nil {
+		panic("Couldn't read the NDJSON file: " + err.Error())
+	}
+	return count
+}
+
+// ndjsonResourceType returns the resource type of the NDJSON file, from its
+// name
+func ndjsonResourceType(fileName string) string {
+	return strings.TrimSuffix(filepath.Base(fileName), ".ndjson")
+}
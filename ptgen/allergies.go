@@ -0,0 +1,151 @@
+package ptgen
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+	"github.com/jmcvetta/randutil"
+)
+
+// allergen is a substance a patient may be allergic or intolerant to, with the
+// percentage of patients who are, the age range in which the allergy begins,
+// and the reactions it causes
+type allergen struct {
+	Code           string
+	System         string
+	Display        string
+	Category       string
+	Type           string
+	Percent        int
+	MinOnsetAge    int
+	MaxOnsetAge    int
+	Manifestations []string
+}
+
+// allergens are the drug (RxNorm), food and environmental (SNOMED CT)
+// substances patients may be allergic to
+var allergens = []allergen{
+	{"7980", rxNormSystem, "Penicillin G", "medication", "allergy", 8, 5, 60, []string{"hives", "rash", "itching", "anaphylaxis"}},
+	{"723", rxNormSystem, "Amoxicillin", "medication", "allergy", 2, 5, 60, []string{"hives", "rash", "itching"}},
+	{"10180", rxNormSystem, "Sulfamethoxazole", "medication", "allergy", 3, 10, 70, []string{"hives", "rash", "itching"}},
+	{"2670", rxNormSystem, "Codeine", "medication", "intolerance", 2, 18, 70, []string{"nausea", "itching"}},
+	{"1191", rxNormSystem, "Aspirin", "medication", "allergy", 2, 18, 70, []string{"hives", "wheezing", "angioedema"}},
+	{"5640", rxNormSystem, "Ibuprofen", "medication", "allergy", 1, 18, 70, []string{"hives", "wheezing", "angioedema"}},
+	{"29046", rxNormSystem, "Lisinopril", "medication", "intolerance", 1, 40, 80, []string{"cough", "angioedema"}},
+	{"256349002", snomedSystem, "Peanut", "food", "allergy", 1, 1, 10, []string{"hives", "angioedema", "anaphylaxis"}},
+	{"102263004", snomedSystem, "Eggs", "food", "allergy", 1, 1, 10, []string{"hives", "rash", "nausea"}},
+	{"3718001", snomedSystem, "Cow's milk", "food", "intolerance", 2, 1, 40, []string{"nausea", "diarrhea"}},
+	{"227037002", snomedSystem, "Fish", "food", "allergy", 1, 5, 50, []string{"hives", "angioedema", "anaphylaxis"}},
+	{"111088007", snomedSystem, "Latex", "environment", "allergy", 2, 18, 60, []string{"hives", "rash", "itching"}},
+	{"288328004", snomedSystem, "Bee venom", "environment", "allergy", 3, 5, 60, []string{"hives", "angioedema", "anaphylaxis"}},
+	{"260147004", snomedSystem, "House dust mite", "environment", "allergy", 6, 2, 30, []string{"sneezing", "rhinitis", "wheezing"}},
+	{"256277009", snomedSystem, "Grass pollen", "environment", "allergy", 8, 2, 30, []string{"sneezing", "rhinitis", "itching"}},
+	{"264287008", snomedSystem, "Animal dander", "environment", "allergy", 5, 2, 40, []string{"sneezing", "rhinitis", "wheezing"}},
+}
+
+const (
+	rxNormSystem = "http://www.nlm.nih.gov/research/umls/rxnorm"
+	snomedSystem = "http://snomed.info/sct"
+)
+
+// manifestations contains the SNOMED CT code and display of each reaction
+var manifestations = map[string]struct {
+	Code    string
+	Display string
+}{
+	"hives":       {"126485001", "Urticaria"},
+	"rash":        {"271807003", "Eruption of skin"},
+	"itching":     {"418363000", "Itching of skin"},
+	"anaphylaxis": {"39579001", "Anaphylaxis"},
+	"wheezing":    {"56018004", "Wheezing"},
+	"angioedema":  {"41291007", "Angioedema"},
+	"nausea":      {"422587007", "Nausea"},
+	"diarrhea":    {"62315008", "Diarrhea"},
+	"cough":       {"49727002", "Cough"},
+	"sneezing":    {"76067001", "Sneezing"},
+	"rhinitis":    {"61582004", "Allergic rhinitis"},
+}
+
+type reactionSeverity struct {
+	Severity    string
+	Criticality string
+}
+
+// severeReaction is the severity of anaphylaxis
+var severeReaction = reactionSeverity{"severe", "CRITH"}
+
+// reactionSeverities are the DSTU2 reaction severities, weighted by how often
+// each is recorded, with the criticality each implies.  The criticalities
+// follow the mapping hdsfhir uses when converting HDS allergies.
+var reactionSeverities = []randutil.Choice{
+	{Weight: 5, Item: reactionSeverity{"mild", "CRITL"}},
+	{Weight: 3, Item: reactionSeverity{"moderate", "CRITU"}},
+	{Weight: 2, Item: severeReaction},
+}
+
+// allergyStatuses are the DSTU2 allergy statuses, weighted by how often each
+// is recorded
+var allergyStatuses = []randutil.Choice{
+	{Weight: 12, Item: "active"},
+	{Weight: 5, Item: "confirmed"},
+	{Weight: 2, Item: "unconfirmed"},
+	{Weight: 1, Item: "resolved"},
+}
+
+// GenerateAllergies returns the patient's allergies and intolerances.  Each
+// allergy begins at an age typical for its substance, and records a reaction
+// with one or two of the substance's manifestations.  Anaphylaxis is always
+// severe; otherwise the severity is random, and the allergy's criticality
+// follows from it.  No allergy begins after the as-of date.
+func GenerateAllergies(r *rand.Rand, birthDate time.Time, deathDate *models.FHIRDateTime, asOf time.Time) []models.AllergyIntolerance {
+	end := asOf
+	if deathDate != nil {
+		end = deathDate.Time
+	}
+	age := ageOn(birthDate, end)
+	var allergies []models.AllergyIntolerance
+	for _, a := range allergens {
+		allergyDiceRoll := r.Intn(100)
+		if allergyDiceRoll >= a.Percent || age <= a.MinOnsetAge {
+			continue
+		}
+		onsetAge := a.MinOnsetAge + r.Intn(minInt(a.MaxOnsetAge, age)-a.MinOnsetAge)
+		onset := birthDate.AddDate(onsetAge, r.Intn(12), r.Intn(28))
+		if onset.After(end) {
+			onset = end
+		}
+		allergies = append(allergies, newAllergy(r, a, onset.Truncate(time.Hour*24)))
+	}
+	return allergies
+}
+
+func newAllergy(r *rand.Rand, a allergen, onset time.Time) models.AllergyIntolerance {
+	substance := &models.CodeableConcept{Coding: []models.Coding{{Code: a.Code, System: a.System}}, Text: a.Display}
+	onsetDate := &models.FHIRDateTime{Time: onset, Precision: models.Date}
+
+	var reaction []models.CodeableConcept
+	severity := weightedChoice(r, reactionSeverities).Item.(reactionSeverity)
+	for _, i := range r.Perm(len(a.Manifestations))[:1+r.Intn(minInt(2, len(a.Manifestations)))] {
+		m := manifestations[a.Manifestations[i]]
+		reaction = append(reaction, models.CodeableConcept{Coding: []models.Coding{{Code: m.Code, System: snomedSystem}}, Text: m.Display})
+		if a.Manifestations[i] == "anaphylaxis" {
+			severity = severeReaction
+		}
+	}
+
+	ai := models.AllergyIntolerance{Substance: substance, Category: a.Category, Type: a.Type}
+	ai.Id = strconv.FormatInt(r.Int63(), 10)
+	ai.Onset = onsetDate
+	ai.RecordedDate = onsetDate
+	ai.Status = weightedChoice(r, allergyStatuses).Item.(string)
+	ai.Criticality = severity.Criticality
+	ai.Reaction = []models.AllergyIntoleranceReactionComponent{{
+		Substance:     substance,
+		Manifestation: reaction,
+		Onset:         onsetDate,
+		Severity:      severity.Severity,
+	}}
+	return ai
+}
@@ -0,0 +1,286 @@
+// Code generated by go-bindata.
+// sources:
+// data/conditions.json
+// data/medications.json
+// data/places.json
+// DO NOT EDIT!
+
+package ptgen
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"strings"
+	"os"
+	"time"
+	"io/ioutil"
+	"path/filepath"
+)
+
+func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name string
+	size int64
+	mode os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _dataConditionsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xed\x5d\x5b\x6f\xdb\x48\x96\x7e\xef\x5f\x41\xe4\x65\x13\xa0\xad\xd4\x95\x97\x79\xf3\x2d\xb1\x27\x76\x77\x10\xbb\xbb\x67\xb1\x58\x2c\x28\xaa\x6c\x71\x42\xb1\x38\xbc\x38\xad\x59\xec\x7f\xdf\x53\x94\x64\xc9\x71\x9d\xa2\x24\x8b\x8a\x3d\xe3\x06\x1a\xdd\x30\x6d\xe9\xa8\xea\xd3\xb9\x9f\xef\xfc\xd7\x4f\x9e\xf7\xbf\xf0\xaf\xe7\xbd\x49\x74\x3e\x4a\xeb\x54\xe7\xff\x93\x8e\xde\xfc\xc5\xa3\x3f\xcf\x7e\x9c\x26\xa3\x28\xd1\x23\x05\x3f\x7a\x23\x08\x1d\x44\x6f\x96\x0f\x28\x59\x3c\x39\xa7\x64\xf1\xf3\x2a\xd7\x13\x35\x3a\x9e\x3f\xe0\x21\x17\x94\x10\xbe\x78\x3a\x4a\xab\x22\x8b\xa7\xe6\xd1\xd9\xb4\x50\x65\xad\xf2\x0a\xde\x73\xf1\x18\xfe\x32\x4d\xe2\xa5\x14\xe1\xfc\xe7\xfa\x4e\x95\x79\x7a\x3b\xae\x2b\xf3\x97\xf7\xef\x15\x0f\xe3\x5a\x4d\x54\x5e\x1f\x8f\xe3\x3c\x31\x6f\x28\xc8\xfc\xd1\x58\xc5\xd9\xaf\xe5\x89\x8a\xeb\x31\xfc\xf8\x26\xce\x2a\xb5\x78\x0f\x5d\xd6\x71\x96\xd6\xd3\xfb\x3f\x22\xdf\x3f\xb9\x4e\x27\xad\xf4\xbf\xbc\x3f\x5c\xbc\x55\xa9\x12\x23\xc4\xf4\xb4\xaa\xd3\x09\xbc\xab\x79\x5c\xa5\x7f\x5e\xea\xbc\x1e\x57\x8b\x5f\x2a\x4a\x9d\xa8\x51\x53\xaa\x47\x2f\x7d\xff\xe4\xaa\x49\x12\x55\x55\x2b\x8f\x92\xb1\x4a\xbe\xfe\x56\x98\x17\xcc\x75\xae\xde\xdc\xff\xb8\xd4\x79\x9a\xc0\x8f\xeb\xb2\x51\xdf\xbf\xcc\x89\xaa\x92\x32\x2d\xcc\x49\x7d\x27\xe8\x52\x86\xf9\x15\x10\xf3\x8f\xf5\xe9\x2f\xf1\xf2\x63\xc2\xe3\xff\xfb\x19\x45\x03\xb3\xa0\x81\x49\x32\x58\xbe\xf0\x03\x38\x9c\xd2\x15\xa0\x3c\x04\x84\x10\x44\x0a\x42\x7c\x0b\x20\x4e\xd2\x78\xa8\x6a\x55\x61\x60\x10\x56\x30\xd0\x03\x86\xc3\x61\x9f\x68\xb0\xde\xc1\xbf\x18\x0e\xb8\x0d\x07\x11\xe0\xc0\x0a\x83\x0f\x84\x0f\x22\x44\x31\x48\x26\x44\x88\xe0\xa0\xbd\xc3\x34\xc6\x70\x40\x37\x55\x0a\xaf\x28\xd8\x2d\x0a\x84\xcd\x36\x84\x0c\xb1\x0d\x7f\xa5\x12\x53\x06\x92\x93\x50\xd8\xad\xc3\x51\x9c\xd4\xaa\x4c\xe3\xcc\xfb\x9c\xab\x66\x02\x1f\x1e\xc5\x03\xb3\xe2\x41\x1c\xf8\x38\x22\xc0\x24\x59\x31\xb1\x72\xb8\x8f\x21\x41\x19\x86\x89\x7a\x5c\x2a\xf5\x87\x52\x5f\x2b\x17\x34\xbe\xc1\x2f\xec\x0a\x1b\xe6\xb5\x2e\xe0\x75\xcb\x37\x3f\x18\x0b\xd2\x86\x05\x16\x22\x1a\xe1\x1c\x6c\x06\x66\x18\x18\x17\x9c\x90\xc0\x82\x85\x63\x9d\xdf\x2a\x38\xc8\x3b\xe5\x9d\xa9\xb8\xac\xbd\x0f\x71\x9a\x81\xa4\x18\x20\xb8\x15\x10\xf2\x20\xc0\x01\xc1\xb6\xd1\x11\x02\x55\x12\x37\xba\x29\xff\x13\x44\xad\x9e\xe8\x3e\x84\x0e\x54\xf8\x4f\x81\xc5\x55\x53\xde\x82\x38\x5e\xad\xbd\x52\x4d\x40\x34\x6f\x98\xe9\xe4\x6b\x0c\xe7\xec\xdd\x94\x7a\xe2\x25\x71\x39\x4a\xf5\x5d\x5c\x25\x4d\x16\x97\x1e\x9c\x3a\x7c\x1b\xe1\x61\x9c\x8f\xde\xeb\xd2\xbb\x8b\xb3\x3b\x55\xa1\xc8\xe2\x60\xe5\xa9\xf5\xe9\xd5\xb4\x82\xa3\x37\xbf\x33\xae\xeb\xe2\x2f\xef\xdf\x7f\xfb\xf6\x6d\x10\x4f\xe2\x83\xb8\xaa\xf2\x81\x2e\x6f\xdf\xdf\xea\xf7\x49\x51\x3b\x61\x79\x58\xce\x75\xc3\xe9\x64\xa8\x33\x95\xd4\x7a\x32\xed\xc0\xa9\x6f\xc1\x29\xf7\xe5\x20\x60\x56\xa0\x9e\x09\x14\xa8\x8c\x47\xa1\x4f\x08\xb5\x00\xf5\x63\x16\x37\x89\x9e\xa0\x9a\x4a\xbe\x58\xcb\x15\x6e\x6f\xba\x30\x08\x5e\xc4\x95\x2a\x3d\x35\x55\x5e\xb5\x0a\xc6\x51\x93\x28\x2f\xcd\xeb\x32\xd6\x33\xe8\x15\x25\xbc\xcd\xca\xb7\xfd\x11\xd8\x7c\x3f\xf0\x7b\x04\xdb\x79\x99\x8e\xb4\x41\xd8\x7b\xf3\x7f\x33\xb0\x79\xc3\xa9\x37\x93\x7f\xfe\x45\xea\x40\x5f\x60\x41\x5f\x60\x9c\x64\xbb\x9a\xbc\xa4\x1c\x43\x1f\x0f\x18\xb7\x83\x0f\xbe\x14\xe3\x12\xde\x13\xf5\x9f\xfd\x7f\x43\xbf\xe9\x47\x99\xc5\xd0\xea\x22\x05\x83\x10\x71\x91\xe8\xf2\xc9\xc3\xfb\xf6\xa9\x60\x84\x08\xcb\x7d\x9f\xe7\x37\x59\xa3\xf2\x7f\xa2\xda\x26\xb0\xde\x37\x3f\x10\x3b\xf6\x8b\xe4\xf3\x71\x8b\x9e\xc3\xd5\x47\xb6\xef\x3a\xe7\x83\xe5\x97\xf6\xe1\x77\x3d\xa4\x03\x24\x46\xf2\x45\x28\x23\x7b\x8c\xf4\x2b\x28\x36\x5d\xe8\x52\x57\xf8\xf7\x3d\xda\xc7\xf7\x1d\xbd\xfc\x4a\xdd\xa9\xbc\xd3\x07\x72\x7e\xed\xd9\x66\x97\x9f\xde\x7c\x5e\xfc\xde\xc1\x71\x5c\xd5\x5f\x8c\x63\x13\x67\x1b\xb9\x42\x15\xf8\x99\xde\x38\x2d\xc0\x09\x82\x00\xc4\x65\x75\x58\xc0\x18\xe9\xcf\xea\x1c\x67\xba\x52\x23\xf8\xe2\xc1\x2d\x98\x4b\xf2\xf4\x8d\x77\x98\xa8\x3a\x1e\x36\x59\x33\xf1\xde\x9e\x81\x8c\x57\xe0\xb1\xa9\xfa\x9d\xf7\x61\x21\xab\x1b\x98\x94\xd8\x94\x92\xef\x23\xbe\xfa\x5f\x05\x43\x60\xc9\x4d\xe4\x6e\xd5\x49\xc7\xb3\x30\xd5\x3b\x82\xff\x24\x63\x97\x31\xa2\xe1\xe6\x51\x9b\xf8\x01\x4e\xba\x5b\x3b\x51\x07\x42\xd9\x96\xfa\xe9\xb1\x6b\x3e\x02\xf0\xdc\x02\x18\xb2\x26\xbf\xf5\xe0\x4c\xab\x06\x87\x25\x67\x70\x39\xfd\xc1\xf2\x42\x0f\x67\x1e\xd0\xcf\xde\x67\x88\x09\x8c\x0f\x3e\xff\x9a\x19\x80\x5e\x80\x80\x5d\x20\xb4\x25\x96\x79\x18\x21\xc9\x83\xb3\x88\xa2\x29\x24\x2a\x69\x18\xda\x7d\x21\x13\x26\xa6\x70\x58\x17\xba\x42\x11\x48\x36\x55\x8f\x81\xdc\xa3\x3f\xd4\xda\xce\xae\xf0\xd0\xa5\x20\xc3\x9d\xc1\x6f\x19\x19\xea\x61\x05\x9e\x40\x52\x9b\xa3\x85\x13\x86\x20\x31\xb7\xe8\xd7\x7b\x3d\x11\x31\xd2\x23\x14\xbf\x28\x3d\x99\xe3\xee\x46\x97\x2a\xbd\xcd\xbd\xa1\x1e\x4d\x67\xe1\xab\xfa\x13\x22\x44\x10\xce\x8b\x1b\x40\x9f\x86\xcf\x33\x93\xb5\x03\x9c\xb6\x3c\xb7\xe4\x12\xcb\x73\x7f\x62\xd1\xca\xa3\xef\xf2\x19\x52\xfa\xf6\x74\xc6\x47\xb0\x4d\x4e\x47\x9d\xb2\xcd\x3d\xb7\x60\x1b\xdd\xc8\x51\xcf\xed\x9b\x7e\xa2\x66\x7c\x79\x7e\x1b\xb5\x26\xb7\x85\x40\x34\xd3\x29\x41\x63\x34\x41\x22\x88\xd1\x48\x68\x2f\x7a\xe9\x7a\x3c\x2d\x75\x0a\x3f\x9c\xa0\x00\xe0\x2f\xbd\xee\x25\xf1\xeb\xe7\x1d\xae\xdb\x13\x72\x58\x05\x98\x24\xd0\x52\xa5\x17\x67\xad\x5e\xa8\xc7\xca\x9b\x9f\x36\xae\xa5\x08\x93\xac\x3f\x2d\xb5\xb0\x92\xd7\x33\x31\xd6\xca\x55\x51\x5b\x82\x9d\x85\x12\x41\xe2\x89\x2f\xd0\x5c\x55\x40\x03\x1e\xac\x7c\x37\x56\xf3\x05\xb9\x9a\x38\x8a\x2c\x02\xf1\xcf\x24\x0e\xc2\x70\xa7\x01\xc4\x3a\x3a\x68\x0d\x24\x32\xb9\x7b\x1b\x79\x94\x69\x6d\x7c\xf3\x38\xaf\x6e\x1a\x53\xbf\x36\x69\x51\xcf\xa0\xc5\x4b\x14\x40\xaf\x7d\x02\xe7\x9c\xd7\xb8\x9f\xe6\x0b\xde\xa3\x71\x9c\x49\x78\xbd\x94\xb0\x0b\x72\xd6\x3c\x7e\xc4\xb0\x84\x85\x40\x95\x5f\x18\x08\x8e\x64\xa8\x4e\x27\xc5\x78\x5a\x29\x3c\x3f\x4a\x25\x62\xf8\xe4\x6e\x83\x56\xfe\xb4\x98\xa0\xaf\xa0\xf5\x91\xe6\xdb\xa8\xea\x67\x9c\x6f\xef\x4e\x43\x74\xa8\x66\x69\x54\xf3\x60\x91\x5b\x75\xc5\x0b\x51\x8f\xc9\xd3\x56\xa8\xdf\x67\x42\x7d\x59\x08\xd5\x85\x45\xdf\xea\x85\xf1\x01\xc7\xbc\xb0\x60\xc0\x91\x10\x81\x33\xe3\x19\xd8\x0a\x8c\x9f\x15\x9c\x5c\xe2\xfd\x96\x25\xcb\xf3\x7e\x84\x47\x7b\xca\xd4\x77\x55\x92\x42\xf2\xdc\xa2\x04\x87\x02\x94\xbb\xb2\xc5\x7f\x80\x69\xcb\x21\x2c\x78\xdf\x5a\x62\x13\x1f\x18\x5b\xbc\xb0\xc1\x15\x18\xbd\x38\x19\xa3\x20\x14\xdc\xe7\xb4\x7f\x1b\xdc\x3a\xde\xeb\x59\x60\x5b\xc2\x5e\x4a\x31\xb0\xe7\xf0\xce\x43\xbc\xd1\x81\xb2\x90\x40\x20\x40\x22\x0b\x06\x7f\x87\x28\x35\xd1\x95\xf2\x7e\x57\x69\x8e\x87\x03\xc1\xe6\xf5\xcc\xd7\xd4\xfd\xf6\x71\x40\x68\x2d\x15\xb2\x81\xb4\xab\x9f\x33\x88\x0f\x39\x72\xf9\xcc\x0f\x02\x1a\xda\xfd\xaf\xcb\x78\x56\xdf\x3a\x51\xb7\x2a\x57\x65\x7b\xe1\x28\x04\xc8\x8b\xad\xdd\xc8\x9d\x03\xe0\x7c\xd2\xba\x56\x6a\xe4\x4d\xd2\x3c\x8d\x4d\xe6\xd3\xab\x55\x06\xbf\xa4\x0b\x53\x3a\x6c\x55\x4e\x01\xa7\x07\xe7\xf1\x1f\x95\xa9\x30\x3a\x4a\x87\x51\x28\x7b\x2c\x1d\xe6\x95\x2a\x5b\x33\x0c\x8a\xf0\x7c\xa5\xa6\x79\xa1\xf2\xca\x03\x35\x0b\x92\x9a\x04\x7e\x07\x20\x6d\x15\x05\x16\x60\x81\xe9\x25\x45\x4b\xd7\x11\x31\x39\x09\x7b\x52\x42\x37\x35\x8a\xbe\x68\xf3\x5c\x6d\xf4\x72\x02\xd2\x6d\xa3\x80\xc3\xfc\x6b\x66\x5c\x2d\x38\xc2\xa4\xfd\xf0\x6d\x14\xd0\x80\x4a\xf7\xe2\x24\x1d\x79\x49\x09\x18\x02\xb3\x53\x22\x75\x88\x65\x90\x16\x90\x1e\x23\xd0\x99\x98\x5f\x96\x62\x76\xf5\x7a\xda\xca\x04\x12\x62\x4c\x2c\x09\x26\xf1\x24\x18\x15\x81\x8f\x64\x42\x8e\x75\x0e\x37\x58\x38\xd5\x1e\xdb\x58\xed\x61\x05\xcc\x7e\x70\xf7\xaf\x96\x02\x63\xd6\xae\xef\xd5\x56\x98\x87\x6e\x4f\x40\x50\xb7\x87\x87\x01\x45\xf2\x9f\x87\xa0\xf5\xca\x2a\xc9\x94\xb3\x7a\x19\xee\x27\x14\xec\xc5\xe6\x31\xf9\x83\x62\x40\x4b\x4a\x2c\x8b\xff\xd1\xa8\x59\x52\x3c\x5e\xb4\x4d\x7d\x8b\xb3\xcc\xd1\xbc\xc5\x65\xd0\xa3\x3e\x3a\xd6\xf0\x81\x62\x10\xf2\x34\x1f\xb5\x12\xad\xe5\x91\x33\x5b\x6a\x5e\x50\x1f\x83\x26\xc4\x84\x58\x82\x82\xb3\x08\x19\x49\xf8\xdc\x64\x93\x99\x6c\xb3\x3e\xc3\x93\xb4\x52\x71\xa5\x36\x83\xa8\xd3\x2f\xe7\xdb\x60\x94\xf6\x9d\x23\xa3\xbb\x4c\xd7\x6e\x04\xd8\xe5\x89\xb7\x3d\x7d\xd3\xd5\x8e\x3e\xef\xad\xab\x16\x35\x6f\x44\x4c\x32\x5d\x57\xf7\x4e\xdf\xfd\xab\x55\x2d\x52\xdf\x39\x30\x1e\xd1\x1e\xd3\x6f\xae\x8f\xd5\x05\x74\x6e\xcd\x7e\x90\x41\x48\x91\xec\x07\xc5\x3b\x15\xa5\x8c\xa4\x3d\xf4\x3c\xad\x74\x31\x86\x83\x6c\x0b\xb8\x37\x59\xf3\xe7\x86\x39\x10\xb2\xe3\x52\x54\xaf\x19\x10\xee\x30\xc5\xd1\xae\x52\x20\x17\x71\x11\x83\x45\x83\x20\x04\x1c\xc0\x07\x2d\x8d\x69\x7e\xa3\xcb\x44\xcd\xe3\x92\xaa\x82\x63\xf7\x86\xaa\x86\x57\x9e\xc1\x56\xcd\xee\xa2\x69\x41\xbd\x56\xc2\x84\x53\xf0\xab\xfa\x8c\x5b\x20\x54\xa9\xc7\xba\x04\x3f\x36\x31\x59\x64\x38\x1b\x0d\xc8\xa9\xea\x4e\xf4\xda\x4a\x17\x64\xb5\x54\xf6\x00\xbc\x87\x84\xa1\x8d\x8e\x84\x31\x4e\xed\xbe\xe3\x55\x6c\xbe\x5e\x2a\xcb\xf0\x99\x00\xba\xb9\xfb\xb0\x55\xf3\xdb\xab\xeb\x38\x3f\x70\x5b\x01\x81\xe0\x2d\xae\x87\x54\x0e\xd0\xbe\x0e\xc1\x42\x4e\x88\x74\x5a\xe8\xeb\x66\xa8\x4a\x88\xa6\x5d\x3e\x24\xb3\x17\xd2\xa9\x3c\x60\x64\xc7\x19\x5c\x46\x9e\xd6\x05\xb9\x8e\x95\x26\x3f\xa6\xa8\xda\x76\x99\xbd\xad\xde\x99\x24\xc6\x70\x3e\x96\xd3\x6a\x2a\x08\x7d\xe3\xb4\x9c\xb5\x25\xcd\x7a\x94\x1c\x65\x06\x2a\x7a\x54\x58\xd7\xad\xae\x6a\xbb\xb4\x57\x9d\x85\xb9\xb0\x07\x37\x69\x96\x29\x08\xc8\xe1\xad\xba\x60\x6c\xab\x3d\x30\x5f\x22\x3d\x72\xa7\x92\x0e\x28\x45\xfc\x4c\xe9\x9b\xca\x2b\xb3\x0d\x37\x81\x54\x80\xde\x14\xc5\x2d\x7f\xde\x71\xef\x5a\x46\xf7\x05\xea\x30\x5b\xd6\x9f\x07\xc1\x20\x90\xc8\x90\x48\x30\xf0\x29\x66\xbf\xa2\xc0\x0c\xba\x5a\xe3\x0c\x08\x81\x6a\xb8\xf0\xcc\x3b\xca\xd2\x7c\x94\xab\x4d\x9b\xd4\xe8\x01\x7f\x4d\xfd\xf7\x01\x80\x10\xe9\xdd\x66\xc8\x9c\x46\x28\x07\x3e\x62\xc5\xc0\x41\xf3\x23\x64\xb6\x11\x3e\xaa\x77\x6c\x94\xd1\x46\xb7\xfe\xa2\xf2\xad\x74\x1f\x2e\xf7\x49\x19\xa7\xf9\x5c\xb1\xb7\x16\x69\xae\xe8\xc7\x10\x6b\x79\xdf\xd2\x7a\xec\x0d\xcd\x51\x27\xe3\xb4\x98\x8f\xb5\x99\x8c\x3b\x58\x2c\x3d\x3b\xed\xca\xab\xd3\xf1\xc3\x82\x01\x9e\xa5\x25\x3e\xed\xb1\x54\x70\x58\x15\xe9\xac\x06\x04\xfe\xf6\x4a\xcf\xf7\x12\x2b\x1d\xd0\xb5\x15\x09\x42\x8a\x66\x6d\xaf\x7c\x36\xa0\x24\x3a\xc4\x8a\x96\xd2\x27\x12\xe9\xf1\x8e\xcb\xc2\xa8\x2e\x23\xd8\x87\xef\xba\xe4\xd7\xec\xb1\x3d\xa0\x2f\xca\x92\x89\x27\x60\x79\x83\x41\x84\x2f\xaa\x52\xf5\xfd\xe0\x01\xa0\xd8\x20\x7a\x68\x53\x8b\xcb\xf2\xa2\x4f\x64\x9f\x1d\xb6\x46\xa0\x33\x23\xc6\x9a\x23\x06\xdc\x56\x3b\x08\x99\xc4\x14\xe8\x55\x04\xf1\xdf\x56\x28\xfc\xa0\x75\xfd\x0a\xbf\x3e\xe1\x77\x63\x4e\xd8\x0d\xbf\x50\xf0\xde\xe1\xf7\xf0\xa2\x3b\xe0\x67\xab\x5f\x48\x81\x24\xce\x78\x80\x58\x6e\x61\xfc\x36\x6b\xe2\xe1\xb0\x28\x14\xbc\x63\xe2\xea\xde\x26\x7b\x1a\xbb\xe3\x7d\x8e\xdd\x45\x72\x0f\xe6\x7b\x76\x9a\xf3\xac\x28\xda\x1b\x24\x22\xd9\x63\xe4\xf8\x40\x86\x0e\x74\xd9\x4a\x10\x91\x40\x27\xfb\xae\x19\x83\x47\x98\x72\x83\x2f\x0f\x8d\x7c\xfb\x74\xdf\x07\x0d\x2e\x40\x39\xf1\x8e\x9a\x32\xdf\x54\xb7\xb1\xd7\xe2\x68\x4f\xdc\x37\xdc\x7a\xfd\x72\xb0\xbc\xc2\xef\xae\x5f\x6c\x79\xfd\xd7\x63\xb8\xcf\x2d\x2e\x9f\x6d\xa3\x63\x5e\x2f\x7f\xad\xcb\xb7\xe7\xb5\xc5\x80\x21\x79\x6d\x7c\x80\xdf\x47\xa7\xd6\xae\xe0\xde\x5d\x49\x6d\xb2\x79\x4b\x7e\xf4\x7a\xe9\x4f\xb8\x74\x6b\x4e\x9b\xa1\xc5\x0c\x86\x5e\x7a\x20\x03\xc2\xec\x2e\xc5\x51\xd9\x98\x99\x01\x67\x1a\x1b\x61\x3e\x03\x47\x76\xd7\xe5\x0c\xd6\x7b\x16\xfb\x05\xc2\xc0\x96\x13\x26\x66\xa2\xdf\x0e\x03\x1e\xa0\xfd\x50\x2c\x08\x39\xd2\x15\xf3\xc7\x58\xeb\xc2\x34\x4e\x1f\xeb\x06\xb4\xff\xdb\xa3\x81\xf7\x59\x95\x75\x53\x01\x30\xde\xa1\xc8\x90\xbb\x73\x36\x5f\x75\xc2\x5a\x60\xb0\xe5\x88\x49\x88\x11\x63\x1e\x06\x28\xf9\x19\x13\xc4\xa7\x1c\xf1\x00\xa6\xc5\xb8\xa9\x36\x23\x3c\xe3\x07\xd1\xae\x23\x8c\x27\xd6\xb4\x7a\x28\x13\x3c\x1b\xde\x33\x6e\x4b\x15\x93\x00\x23\xc1\x3b\x62\x3e\xee\x10\x44\x61\x64\xaf\x76\x5e\x36\x93\x62\xe3\x38\xd3\xe5\x0d\xc8\xd7\x6f\xfe\xf6\x37\x6e\x6f\xc3\x66\x03\x61\x2f\x0d\x06\xe1\x40\x22\xb4\x87\x32\x64\x08\xa9\x4f\x4b\x8a\x9b\xa5\x45\x3a\x72\x0e\x67\xd2\x67\xdd\x93\xb3\xce\x54\xa6\x2b\xa7\x25\xfb\xec\x38\x9b\x4d\xdf\x27\xde\xd1\xd4\xb4\xe2\xdc\xf3\x77\xe1\xa3\x49\xa1\x08\xfa\x4b\x3f\x3c\x94\xa6\x8b\x77\xd3\x96\x5d\x0d\xc0\xf6\xd8\xf3\x0f\x5f\xf0\x21\x4d\x21\xa8\x29\x4e\x45\x56\x16\xde\xaa\x6e\xca\xa1\xb9\xa7\xca\x14\x20\xae\x26\x66\xa2\xd5\xe4\x80\xaf\x41\x56\xb5\x3b\x48\xee\xd7\x0f\x59\x03\x93\xbe\x03\x93\x81\xdc\x01\x9b\x46\x55\xeb\x52\x79\x95\xca\x2b\x43\x48\x51\xc0\xc7\xfd\x16\x4f\x4d\xf3\xe2\x9d\xce\xda\x99\xb9\xea\xfe\xac\xeb\xd5\xb3\x7e\x04\xca\x28\x90\x9c\xf7\x07\xca\xab\xb9\x84\xe7\x79\xad\x6e\x4d\x51\xea\x4e\x79\xd7\x2a\x19\xe7\xe9\x3f\x1a\xd5\x09\x52\x5b\x0e\x96\xfa\x98\x61\x3c\xe6\x02\xed\x21\x8f\xb8\x89\x94\xad\xa1\x72\x3b\x57\x7a\x6c\x2e\x0e\x1d\xde\x64\xd1\xe6\xed\xb9\x74\x1b\x03\x19\x90\xa7\xb4\xe7\xb6\x4e\xd2\x83\xdf\xda\xac\x02\x60\xa3\x19\x82\xff\x9d\xb4\x95\xce\xb8\x98\x6e\xde\xf0\x53\x37\xf0\x41\xee\x7b\x6a\xef\xdb\x00\x93\xf8\x0e\x3e\x9a\xa3\xc3\x47\x12\xde\xf3\x20\xf1\xb5\x91\x6c\x41\x38\xd4\x85\x43\x5b\xb6\x96\x4a\x2c\x78\x3f\xa6\x21\xee\xa0\x71\x61\x46\x38\xa5\xb5\x93\x23\xd3\x79\x07\x10\xb9\xdd\x53\x0b\x0f\xe8\xae\x0d\xb6\x74\x21\x71\xba\x0b\x24\x06\x1b\x12\xd2\x6e\x82\xc4\x63\x57\xe3\x77\x6c\x08\x7c\xe0\x90\x75\x53\x79\x85\xbe\x1f\xaa\x33\x08\x4d\xcc\x1d\xbc\x73\x14\x10\x7a\x6d\x3d\x5b\x0c\x17\x2f\xa5\x6f\x1b\x0f\x0e\xf3\xd8\x34\xf1\xea\xee\xf1\x3e\x61\xcb\x2b\x53\x34\x92\x38\x16\x2e\x6e\x50\x26\x10\x72\xfd\xab\xaf\xe9\x02\xa7\xde\xdb\x4b\x95\xc5\xf0\x97\x31\x9a\x57\xe0\x74\x8b\x0e\x14\xb9\xdb\xb6\xc9\x1d\xe9\xce\xc0\x55\xc9\x0a\xb7\xb3\xea\x97\x7a\x5c\xdd\xb7\x78\xbf\x8d\x0d\xff\xd4\xc4\xa0\xb1\x32\x87\x0c\x16\xf3\xa6\x65\xc8\x6a\xc1\xdb\x4e\xdd\xad\x60\xb7\xfd\x15\x93\xf0\xab\x70\xc4\xd2\x80\xd3\x1e\xc7\xe1\x5b\xe9\x2f\xd3\xa4\xd4\x20\x6a\x31\x36\x1d\xde\x0b\xe3\xde\x05\x55\x5b\x16\x5c\x86\x72\x49\xbf\xf0\x00\xaa\xbf\x80\x4e\xc5\x26\xa3\x05\xe7\xd4\x60\x95\x39\x58\x04\x3f\xa5\xa3\x5c\x4d\xbb\xa6\x70\xc8\x7e\x06\xc5\x70\xa4\xee\x80\xe9\x92\xee\x69\x33\xc0\xfc\x44\xaf\xbb\xf9\x69\xc0\x98\xf7\x39\x61\xf0\x58\x90\x0e\xe4\x49\xab\x35\x97\x48\xfd\xe5\x98\xa1\x73\x05\xe0\x54\x06\xc4\x3e\x91\x7f\x01\x8e\x6e\xd9\x65\xcc\x19\x42\x09\x12\x3a\xc8\xe5\xb7\xd1\x8c\xa1\x7c\x12\x47\xcd\x3a\xaa\xd1\x35\xa6\x28\x9e\x68\xcc\xcf\x14\xc4\x37\x5b\x99\xf3\xcc\xdc\x82\xc3\x9c\x07\x94\xed\xc1\x9c\xaf\xc8\xdf\x05\x4d\x5b\x79\x40\x04\x21\xb6\x0e\x83\x47\xe8\x3a\x0c\x42\xcc\x0a\x04\x9b\x4a\xfc\xad\x28\x94\xf1\x7c\x67\x5d\x82\x26\x38\x33\x53\x39\x9d\x13\x8a\x08\xa1\x92\xb3\x03\x6a\xab\x92\x21\x7b\xe5\x80\x7e\x80\x09\x2b\x7f\x4c\x40\x07\xf6\x46\xf2\x4f\x81\x40\xfb\x88\x69\x14\xa1\x7b\x31\xd2\xb2\x1c\x1b\x0f\xb3\xe5\x5e\x35\x5f\x1b\x54\x6b\xf1\xfd\x18\x49\xf1\x24\x77\x6e\x6b\x5e\xad\x9d\xd1\x18\xcd\x2c\xc0\x1a\xf4\x6d\xa0\x85\xb8\xdf\x63\xb4\xdb\xca\xb1\xbe\x75\xb4\x15\x23\x28\x0d\x10\xbf\xec\x48\xa0\x84\x81\xbe\x94\x12\xa9\x49\x1d\x56\xa0\x82\x6e\x53\x77\xa9\x9a\xdb\x4b\xd5\x8c\x1c\x70\x47\xb0\x2b\xb7\xc1\x9a\xdf\x7b\xe8\xe0\xf2\xca\xc4\xae\x20\x37\x1b\x5d\x82\xd3\x30\x4c\x30\x0f\x4c\xe5\xc8\x74\xb7\x2f\xe6\x9c\xe7\x76\xb3\x9d\xb5\x9a\x00\x94\xde\xb9\x12\x31\x52\xf4\x3d\x6a\x35\x97\xb7\x0b\x97\xb6\x92\x09\x7c\x71\x90\xca\xf9\x45\x04\x6a\x10\xf1\xda\x64\xcb\xe4\x66\x4b\xc1\x1c\xa6\xf9\xb8\x99\xec\x8c\xe5\xf9\xd9\x8c\xcf\xf8\x1b\x8e\xfb\xad\x63\xf7\xae\x35\xb8\x5e\x93\xa2\xa9\x1f\xd0\xae\x58\x1a\x7b\xc3\x3e\x7d\x2c\x23\xc5\xe1\x52\x8a\x8e\x05\x53\xc4\x3a\x91\xe7\xa3\x13\x79\x1c\x9b\x2b\x75\x0c\xe4\x1d\x96\xe9\x50\xdf\x64\xf1\x5d\x9a\xbb\xb4\x1b\x79\x1d\xcb\xfb\x11\x2b\xc6\xa8\xb5\xf0\xea\x23\xb1\xdf\x69\x18\xac\x8c\x1b\x7c\xe7\x61\xd3\x36\xfa\xb3\xb6\x76\x27\xe9\xc8\x69\xd9\xf6\xe5\x4a\xcb\xbe\xcd\xda\x0b\x44\x80\x2d\x97\x4f\x04\xc5\x86\x9b\x8e\x22\x89\xd5\xde\xc3\xc0\x67\xc8\xde\xc9\xab\xba\x54\x45\xad\x13\x9d\x24\x78\xef\x0d\xf7\x77\x37\x53\xf2\xe3\xb6\xea\xb0\x7d\xb4\xf7\x5f\xeb\xbc\x4a\x17\x49\x71\xf0\x67\x8a\xd2\x24\xca\x6a\xef\xa6\x69\x29\xfb\xda\xe3\xf6\xae\xc7\xa5\x8e\x1d\x7e\x36\x0b\x05\xeb\xd3\x12\x2d\x45\xec\xc2\xa0\x2d\x4d\xef\x47\x3e\x52\x7c\xbf\x10\x0e\x16\x3e\x8a\x24\x3e\x3f\x57\xba\x4c\x63\x97\x12\x0a\x5e\x77\x78\xed\x4f\xeb\x58\x69\xd8\x89\xc0\x28\x2d\x8e\x1d\xbb\x4a\x22\x4e\x4d\x14\x6f\x6b\xb8\xb8\x98\x4e\x8a\x71\x6c\xb8\x88\x2f\x54\xf3\xd5\xd5\xf6\xc3\x43\x34\xb8\x92\x3b\x5e\x6d\x19\xf4\x9f\x7d\xdc\x0c\x10\x9b\xe4\x1e\x7b\x07\x86\xb4\x3a\x24\x11\x36\x09\x72\x12\x72\x9c\xa2\x49\xb4\x91\x0d\xb5\xd6\x96\x27\x13\x9d\x7b\x86\x24\x38\x1e\x66\xca\x3b\x9f\x4c\x9a\x1c\xfe\xee\x26\x4d\x52\x95\x4f\x77\x58\x0f\x91\xcf\xab\x11\x87\x6f\xd8\x88\xb3\x95\x75\x6a\xe7\x97\x2f\xe3\xb2\xd4\xdf\xde\x5f\x19\xe6\xfe\x63\xd3\x7b\xb3\x46\x65\x84\x87\x4c\xf4\x58\x9d\x3b\x53\x70\x52\xba\xd0\xa9\x32\x2a\x01\x3e\xd8\xad\xca\xcd\x06\x9b\xef\xe5\x5b\x2b\x76\xb2\x6e\x3d\x65\x18\x8d\xf5\x47\xb2\x62\xcd\x1e\xb1\x58\x8b\x00\x59\x24\xd1\xb2\x45\x55\x45\x6a\xd6\xed\x1c\x0e\xab\x44\xed\x70\xe7\x52\x28\x5f\x4e\x8f\x98\xad\x6f\x71\x1b\xde\x9d\x2f\xb3\x54\x8f\xe3\x58\x2d\xe4\x04\xa4\xcf\x41\xf0\x2b\x7d\x53\x7b\xd7\xed\xe2\x31\xaf\xe5\x5c\x88\x6f\xd5\xfb\xf5\xda\x70\x64\x80\x40\x90\xa0\x10\x24\x68\xcf\x62\xcb\xa4\x2e\x30\x08\x26\xf0\xdd\x48\x5f\x31\xb8\x73\x0c\x22\xe7\xfa\x92\x40\x68\xe5\x75\x09\xc9\x40\xda\x53\x08\x1f\x05\x3e\xc2\x43\x23\x2e\x7c\xc4\x62\x9f\xe7\x95\x9e\x38\x36\xd5\xf3\x8d\x57\x72\xf2\x67\x86\xbd\x17\xe8\xc5\xdb\x72\xd0\x70\x85\x83\x00\xd1\x40\x82\xc3\x23\xb4\xc3\x2a\x30\x43\xbb\x91\xa3\x69\xe5\x32\xbd\x6d\x59\x69\x50\xfd\x23\xc8\x2b\x08\xf6\x0d\x02\x9f\x58\x41\x20\xb1\x50\xee\xa3\x89\xde\x31\x10\x80\x27\x24\x03\x84\x55\xb5\x48\x33\x55\x54\xa8\x6f\x2e\xe8\x3e\xe2\xf7\x8e\xb9\xad\xa7\xd5\x61\x5f\xe0\xe5\x5b\x57\x8e\xfa\x64\xa5\xe4\xfe\x70\x70\x9f\x1a\x5c\xfc\x0d\x19\xdc\x17\x34\x08\x84\x8f\x91\xd2\xcc\xf6\x50\x1e\x99\x3d\x94\x69\xee\x9d\x4e\xd5\xce\xbc\x90\xed\xda\x2b\x7f\x18\x47\xc8\xd3\xe7\x25\xe6\x2e\xc8\xe9\x64\xa8\x46\x23\x35\xf2\x1c\x87\xfb\x78\xaf\x87\x64\xb4\xd7\xd5\xa3\xf7\x2b\x6f\x1f\x48\xd5\x52\x8c\x9d\x2e\x56\x8f\x1a\x01\x3b\x90\x69\xcb\x6b\x9f\x86\x34\x42\xbc\xe3\xdf\xc3\x68\xc0\xfe\xb6\x0d\x32\xcf\xf3\xbf\x37\x65\xaa\xe6\x2c\x68\xb1\x77\xa9\x4d\x48\xf9\xbb\x1a\xa7\x49\xa6\xbc\xc3\x24\x31\xfb\x9b\xea\x3d\x70\x8d\x38\x73\xde\x14\x55\x5c\xa3\x78\xba\xaf\xfe\xa1\xad\xb2\x09\xbd\xeb\x30\x6e\x45\x4a\x88\xad\x66\xf8\xc3\x2c\x81\xd9\x09\x52\x3e\xb4\xbb\x34\x73\xef\xaa\x8e\xd3\xb2\xfa\xd1\x08\x91\xbd\x00\xc4\xdf\x07\x59\xe1\xbf\xcd\x9e\x79\xdf\xda\x25\xce\x50\x9e\xa4\x4f\x44\xae\x3c\xfa\xae\x1f\xc9\xe7\x21\x92\x37\xff\x98\xe6\xb7\xe9\x9d\x8b\x89\x4b\xb0\xd7\x6a\xc9\xfe\x34\x94\xb4\xd6\xc7\x18\x16\x67\x5d\x48\x74\x22\x9e\x86\x2c\x08\x99\xbd\xe7\xf1\x44\x95\x70\x80\xe6\xd6\xbd\x51\xa3\xcc\x97\xe9\xaa\x01\x5f\xe0\xcf\x42\x57\x0e\x22\x40\xac\x0f\xf6\x15\x09\x7d\x20\xc1\xb7\xb2\xe8\x06\xd8\xfe\xce\x4b\x41\xd1\x86\x0d\x16\x85\xdc\x40\xc1\x46\xa3\x7b\x95\xe8\x2c\x6d\xdb\x5f\xcf\x47\xa9\x36\xe3\xb4\x69\xb2\x29\x61\xd6\x4b\xd8\x26\x24\xf7\xe0\xbf\xac\x18\xa7\x63\x5d\x9a\x95\x3c\xde\x55\x91\x1a\xea\xd9\xa6\xbc\x8b\xdd\x06\x8a\x85\x7d\xae\x2f\xfb\xac\x2b\x43\xe2\x0e\xde\xea\x61\x09\x51\x0b\x3c\xe9\xee\x3b\xf4\x03\x6b\xbe\x2f\xc4\x10\xf8\x85\x33\x44\x13\x41\x20\x61\xca\x73\xd6\x96\xfc\x32\x6d\x17\x11\x9c\xe7\xf0\xee\x35\x9c\x95\xb9\xad\x5d\x45\x7b\xfc\xb9\xf1\x35\x38\x62\xbd\xe0\x29\x3c\x2d\xdf\xad\x48\xbc\xc9\xe2\xba\x2d\x76\xfe\x56\x2a\xb8\xed\x38\x7b\x7f\x94\xc5\x10\xfe\x95\xde\x2f\xf0\xda\x80\xc9\x71\x9a\x27\xb6\x97\xbd\xef\xf0\xe2\x42\xec\x6f\xa3\xe3\xda\xe2\x76\xe0\xd5\x96\x9f\x16\x1c\x1b\x01\x3d\xf7\xd1\xe9\x26\xca\x43\xdf\x51\xa5\x5b\xa4\xf2\xcf\x20\x80\x2d\xcb\x71\x7c\x0b\x3e\xd4\xa6\x75\x64\xd7\xd4\x32\x32\xe9\xe4\x74\xe9\x83\x6d\x7d\xfa\x35\x60\xeb\x4a\x51\xb0\xa7\x94\x4a\x8e\xcd\x41\x2e\x5b\x9b\xe6\xb9\x8a\x59\xed\x76\xb9\xdf\xfc\x71\x5e\x82\x72\xda\xe7\x6a\x35\xb7\x54\x1d\x20\xb4\x26\xca\x41\x69\x22\x0e\xdc\x19\xf8\x76\x58\x8a\xd4\xac\xfe\xa3\xf6\xf8\xf2\x57\xb8\xe3\xdb\x34\xf6\xde\x9e\xc6\xed\x5e\x21\x74\x06\x59\xf0\xd7\xe5\x8f\xfb\x74\xdb\x02\xeb\xde\x4f\x8e\x0d\x91\x7c\xe2\x14\xed\xb1\xa4\x04\xdb\xfa\xf9\xeb\xb0\x02\x65\x90\x2c\x34\xe8\x49\x03\x7f\x92\x6f\xda\xbd\xef\x1c\x43\x7f\x46\x5b\x9c\x22\xd2\x6b\x82\xb4\xbc\xaf\xd1\x2a\x10\x29\x6f\xd7\x89\xcc\xb6\xe3\x39\xb8\x10\x7a\x5d\x62\xb0\x22\xca\x95\xa1\x35\x02\xbf\x71\xbd\xb5\x61\x81\x95\xb8\x5b\x12\xac\x3e\xf3\x49\x38\x36\x8f\x46\x7e\xeb\xb3\xd9\x8d\xe0\x6d\xd3\x8a\x77\x66\xf0\x14\x6f\xba\x75\x85\xbd\x08\xa2\xd5\x4d\xb9\xbb\xb7\xdb\x70\xf7\x20\x53\xdf\xee\x81\x42\x0e\xf7\x31\x08\x23\xbf\x4f\x46\xef\x0d\xa5\xec\x00\xa6\x2d\x43\x1f\x06\x7c\xe0\xdb\xb5\xe2\x15\x61\x03\xb9\x55\xde\xf5\xa8\xd4\x5f\x55\xee\x5d\x6b\x5d\x8f\x5f\xec\x3e\x83\x8e\xae\x73\x87\x3e\x0c\xb7\xd4\x87\x5f\xcc\x7a\x80\x24\xce\x1d\xfb\xb5\x05\x0d\xa3\xa8\xc7\x62\x90\x91\xe0\xb8\x95\xa0\x03\x4b\xdc\x1a\x95\x06\xd8\x72\x8c\x2f\x94\x0f\x28\xd6\x0c\x45\x02\x1e\xd9\x75\xdc\xc9\xb4\x32\xdb\x24\x8d\x8b\x75\x5d\xea\xc6\x44\x27\x57\x66\xe1\xaf\xfe\x06\xce\xfe\xbb\x1d\x42\x4b\x3e\xbf\x3d\x9f\x72\x87\xac\x82\x4e\x02\x41\x5d\xcd\x98\x0d\x3e\x28\x38\xc7\x96\x99\x6a\xa8\xde\xb9\x48\x04\x39\xe9\x99\x44\xd0\x2e\x51\x07\x22\xad\x99\xfa\x08\x2b\x3f\xfe\xc2\x23\xac\x39\xcf\x0f\xa5\x8f\x50\x64\x2d\xf2\x24\x33\xc2\x02\x88\x99\x55\xe2\xda\xf9\x2e\xc4\xe6\xdc\xf6\x2f\x96\xe5\xfc\xd9\xd4\x17\x03\x69\xcd\x40\xa0\xb3\x0e\xe7\x3e\x9a\xbd\xf7\x59\x64\xe6\x5b\x88\x7d\xc2\x0a\x8c\x5c\x4b\x90\xa5\x1b\x50\x9a\xaa\x54\xc3\x12\x4c\x31\x80\x22\x2e\xdd\xa8\x90\x48\x24\xe0\x20\xf3\x0b\xe4\x4e\x19\x0c\x9e\x9e\x8d\x70\x51\x51\xd1\xa7\xa0\xe3\xfe\x20\xcd\x34\x95\xd9\x34\x3d\x35\x59\xf1\xe1\x8c\xc1\xb1\x34\x43\x57\x26\xde\xfa\xd0\x54\xae\xa1\x5f\x1e\xd0\xa8\xc7\xd8\x60\x23\x19\x3b\xc0\xea\x63\x60\xa5\x08\x58\xf9\x40\xa2\x3c\x54\x4c\x12\x61\xaf\x35\xad\xc0\x75\x07\x58\x95\x2e\x8a\xa0\xad\xb0\xea\x6f\x8b\xd5\x75\xc6\x73\x5c\x51\x84\x7c\xc5\xea\xda\x58\xb5\x95\x22\x58\xe4\x63\xa5\x88\x0f\xdc\x41\xef\x47\xda\x21\x32\x9b\x66\xbd\x8c\xff\xae\x4b\xef\x44\x15\x25\xdc\x90\xe1\x6b\x3d\x49\x2b\x5d\x8e\x70\x46\x18\xb1\xf1\x5a\x77\xff\xb5\x24\xba\xbd\x79\x0d\x11\x3e\x20\x86\xf0\x01\x11\x9c\x38\x8f\x81\x9b\x25\x10\x2a\x8c\x2c\xd1\x63\x9d\x41\xdc\xfb\x64\x6a\x20\x27\x4d\xee\x33\xa3\x06\xa2\xaf\xd4\x40\x38\xf2\xac\x14\x2c\xa1\x9d\x87\xea\xd8\xa7\x58\x4e\x2d\x22\x7e\x68\x2f\xc3\x7f\x36\xa1\x07\x9c\x60\x17\x1d\x73\xb0\x27\xbf\x9e\x46\xcf\x8d\x52\x74\x93\xd9\xd5\x2f\xf1\xa8\xdd\x0c\xbd\x38\x55\xf7\x72\x3c\x29\xc3\x5e\xfb\x5c\xad\xc2\xb8\x01\x17\x12\x2b\x9d\xad\xc0\xe8\x6c\x4d\x92\x17\xdb\xa1\xcd\xa4\x08\x39\xd2\x6a\x7f\x54\x2a\x08\x7b\xbb\x40\x87\x4c\x4c\xbb\xf6\x67\x8b\xad\xf8\xef\x44\xdf\xa0\xf3\x7b\x64\x5e\xbe\x34\x6c\xf3\x28\x57\x63\x11\x97\xb5\x07\xde\x45\xdb\x08\x7a\xf3\x80\xba\x71\xd8\x5e\x82\x83\xc9\x36\xe2\x84\xf6\x4f\xd6\xb8\x94\xbf\x0b\x9c\xb6\x3a\x43\x14\x85\x03\x69\x47\xe7\x75\x48\x07\x02\x1f\x05\x20\x01\xe7\xf6\x6c\xae\xe9\x5a\x39\xf8\xb5\x50\x73\xf6\xfc\xce\xac\x07\x0d\x7f\x74\x03\xed\x2e\x26\x02\x64\x4f\x83\x89\xe7\x79\x92\x1a\x5f\xbb\x5d\x8d\xb0\x98\xfd\x33\x58\x34\xc7\xac\xef\x4f\xf9\x0f\xdd\xc0\xf3\x47\x67\xfd\x18\x95\x84\x86\xa4\xcf\x2a\xd8\x56\xd2\x76\x20\xd7\x56\x88\x10\x2c\x18\x70\x24\xe6\x15\xe1\x4a\x38\xfc\x1d\x6e\x23\xc1\x91\x2a\xc4\x61\x5d\x9a\x2f\xd4\x87\x74\x58\xa6\x59\x16\x6f\x11\xe6\xee\xb8\xa7\xf2\x89\x78\xdd\x61\x1c\xb1\x36\x5a\x51\xdc\xf5\x07\xb9\x16\x3c\x3f\xfd\xf7\x4f\xff\x0f\xc8\x80\x90\xa8\xd0\xbf\x00\x00")
+
+func dataConditionsJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataConditionsJson,
+		"data/conditions.json",
+	)
+}
+
+func dataConditionsJson() (*asset, error) {
+	bytes, err := dataConditionsJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/conditions.json", size: 49104, mode: os.FileMode(420), modTime: time.Unix(1792322871, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataMedicationsJson = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x9a\x6d\x6f\xdb\x38\x12\xc7\xdf\xf7\x53\x10\x79\x71\x68\x81\x5e\x23\x52\xcf\xf7\xe6\xa0\xb8\x49\x1a\x20\x4f\x68\x72\xc5\x5d\x0f\x8b\x05\x2d\x31\x11\x77\x29\x51\xa0\xa4\xd4\xf6\x62\xbf\xfb\x0e\xed\xa4\x48\xdb\xd1\x86\x74\x9c\xbe\x28\x1a\x5b\x0f\xd6\xcf\x33\xfc\xcf\x7f\x86\xfe\xff\x2b\x42\xfe\x80\x7f\x84\xec\x35\xa2\x92\x25\x1f\xa4\x6e\x7f\x95\xd5\xde\xbf\x08\x7d\xbb\x79\xdf\x2c\xce\xb5\x69\x66\xba\x12\xf0\xe6\x5e\x9e\xa7\x8c\x45\x7b\xf7\xc7\xe6\x86\xb7\xd5\x39\x6f\xd6\x87\x0a\x23\x4b\xd1\x0d\xdf\x1c\xfb\x37\x1c\x18\xcc\x28\xee\xdf\x1b\x0c\xaf\xc4\xc3\xf9\xef\x75\x2b\x3a\xb1\x92\x8a\x7c\x58\x56\x46\x97\xb5\xd2\x46\x56\x82\xd0\xa0\xb9\x25\x17\x86\x2b\x72\xcd\xe7\x4a\x7c\xbd\x21\xef\xcf\x85\xa8\x84\x7d\xb6\x1b\xae\xfa\x87\x7b\x1a\x3e\xac\x6f\x47\x03\x02\xd7\xed\x93\x8a\x2f\xf7\xe0\xc8\x9f\x6f\xa7\xd1\x18\x8a\x46\x23\x9a\x27\x0c\x45\x3b\xdf\x2f\x7e\xc0\x7a\xfc\x0c\xdf\x70\x15\x2b\x39\xd4\x46\x37\xcb\x52\xb6\x84\xc5\x5f\x69\x66\xbc\xeb\x47\x25\x1c\x71\xe2\xc0\x9d\x27\xc4\x79\x82\x28\x4c\x13\x94\xe7\xb3\xe8\x07\x23\x95\x73\xa8\x4e\x65\x2f\x5b\xdd\xc1\x25\x24\xde\x2a\x38\xb1\x33\x4b\x84\xb2\x64\x49\x90\xe7\x19\xca\x72\xa4\xcd\x00\x7f\xba\xe7\xdd\x99\x18\x6e\xe0\xd6\x10\x9c\xef\xf3\x2e\xb0\xb1\x3a\x5c\x0c\xa2\x05\x12\xf2\x51\x28\xc1\x7b\xb1\x55\x2a\x7a\x04\x2f\xc6\x83\x47\xb3\x84\xe5\x29\x4a\xfc\xdf\xe2\xb4\xb8\x2e\xce\xc9\x45\x57\x0f\x35\x57\x8d\x2c\xc9\x95\x56\xa3\xbd\xa5\xf3\x97\xf0\x28\xa3\x9f\x82\x21\xf0\x25\x75\x6e\x2c\x09\xca\x12\x26\x79\x90\x06\x28\xca\xf5\x52\x89\x56\xbb\x27\x62\x51\x0a\x08\xb6\xcd\xc5\x5a\xb4\x24\x59\x2f\xae\xc9\xd0\x3c\xba\xc9\x03\x4c\x98\x7b\x44\x26\x45\x69\x58\x42\x43\x1a\xe3\x34\xf0\x68\xea\x48\x8d\xce\x38\x17\xbd\x50\x96\xe7\x4e\x1a\x92\x6e\xb7\xb2\x68\xec\x0e\x94\x4d\xeb\x04\x9e\x69\xcf\xd0\x89\x97\x57\xf1\x1c\x2f\x50\x41\x14\x31\x3a\xa1\x14\x3d\x6f\xf8\xc2\x3d\xd9\x20\x37\x21\xf7\x5b\x78\xb4\x9f\x80\x43\x03\x94\x27\x89\xa2\x30\xc0\x17\xcf\xe9\x58\x8a\x76\x90\xbd\x33\xd0\x47\xde\xca\xb9\x5c\x8d\x0d\x9f\x93\x93\xf6\x37\x51\x0e\x96\xe5\x07\xf1\x78\x82\x29\x78\x77\xaf\xe6\x8d\x6e\x87\xfa\x49\x2c\xdc\x47\x24\x34\x0d\x29\x8e\xe5\x57\x6c\x07\x6d\xee\x78\x3f\xc0\x27\x42\xb1\xdd\x2e\x48\xcc\x23\x48\x13\xd6\x21\x4f\x63\x8a\x2f\x21\x2f\x9a\x99\xe2\xe6\x91\x79\x88\x83\xed\x80\x7c\xbc\x03\xc5\xcd\x43\x9e\x24\x34\x9b\x48\x3b\x71\xa7\xed\x33\xc2\xe5\xce\xba\xb0\xbe\x64\x69\xf4\x42\xb6\x36\xdf\x2a\x39\x36\x24\x78\x47\xb7\xa2\x83\xeb\xdc\xe9\x26\xec\x44\x94\x27\x14\xb7\x7a\x07\xf2\x46\x18\xee\x4c\x76\x74\x48\x3e\x1c\x9e\x1d\x92\x4b\xad\x96\x1d\xb8\x5f\x6b\x24\x92\xe6\x76\xdf\xbe\xee\x79\x59\xd6\x7c\xed\x2d\x4e\x40\x46\xc8\x4c\x37\x9d\x12\x0b\xc2\x18\x39\x3b\xde\x02\x3c\x71\xc7\x9e\x30\x15\x79\x46\x23\xbc\x74\x79\xa5\xe9\xa5\x11\x55\x2b\x7b\xf0\xef\x3f\x43\x17\x71\x53\x91\x07\x2c\x61\x78\x08\xdf\x8b\x85\x54\xbc\xf5\xe8\x44\xc4\x02\xce\xef\xa1\x70\xf1\x95\x06\x41\x0c\x01\xca\x11\x24\xf4\x00\xc1\xfd\x44\x9e\x64\x74\xa2\xfc\x16\x7d\xa9\x7c\x92\x11\x92\x4e\x56\xba\xe4\xe0\xa8\xac\x4f\xdf\x6f\x4e\x3d\xc2\x71\x0a\x14\x5f\x84\xf8\xfd\x49\x0c\xdc\x45\xb0\x20\xcc\x23\xdc\xa1\x17\x0d\xac\x7b\x0f\x8f\x67\x4f\x2f\xa5\x52\xcf\x69\x9f\xbc\x2c\x38\xc5\xad\x84\x55\xf5\x68\x07\x35\x6a\xa6\x55\x59\x03\x11\x2c\x17\xa8\x9d\x5b\x6a\x9e\x7b\x07\xc5\x70\x23\x41\x59\x94\xa6\x09\xee\x8c\xe0\x09\x79\x29\x3c\x3a\xf7\x72\xec\xad\x2b\xba\x57\x72\x97\x20\x61\x66\xdc\x23\x44\x6c\x62\x1c\x91\x66\x01\x0d\x51\xa6\x93\x66\xfd\x70\xee\x35\x4a\x77\xb0\xd6\x1a\xab\xd6\xdf\xf6\x85\x6c\x2b\x38\xea\xae\xd6\x6c\xd2\x54\x64\xe1\x0e\xe6\x11\x27\x20\xd4\x92\xaf\x64\x05\xba\xb6\x9d\x5a\x7b\x05\x0a\xf7\x13\x21\x05\x99\xdb\x01\xcc\x75\x2d\x6d\xff\x27\xd6\x39\xe7\x6c\x87\xdc\x9f\x1e\xf7\x0b\x31\xf4\xe2\x14\x17\xb7\xab\x71\xed\xd5\x9c\xd3\xec\x5a\xc0\xcb\x72\x59\xaa\x0d\x84\x0f\x85\x07\x06\x5e\xff\x43\x30\xdd\x09\x3e\xbc\xf3\x0a\xc2\xa1\x59\x7e\x37\xe1\x72\x75\xda\x3e\xa1\xc0\xeb\x7e\x96\x45\x21\xcd\xf1\x6e\x55\xf7\x4b\xf7\x40\x5c\x4a\xbb\xde\xef\xeb\xcc\xf6\xdd\x10\x8d\x89\x2b\x10\x5e\xff\xb3\x18\x16\x06\x2e\x61\x47\xd2\x34\xfc\xd6\x63\xaa\xf3\x5e\xdc\x72\x23\x94\x5c\x3c\x03\x28\x0b\x88\x73\x73\xc7\x70\x2f\x10\xa6\x34\xd9\x45\x9e\xcd\x60\x99\xe8\xae\xd6\x7d\x57\x6f\x84\xf9\x85\xa5\x0b\xb7\x01\x61\x94\x47\xe9\x0e\xa4\xeb\x58\xdc\x48\xe8\x52\xe5\xfc\xb1\xab\xf1\x6b\x54\x3d\x96\x4f\x88\xbb\x80\x38\x8a\xe2\x78\xc2\x6d\xda\x3e\xda\x43\xc9\x0e\xc4\x1d\x2c\x9f\xe7\x4e\x13\x36\x40\x2e\xc6\x33\xc4\x2d\x00\x0d\xc0\x77\x86\xb8\x22\xfc\x4f\x98\x3b\xbd\x74\x26\x3a\xe9\xa4\x92\xcd\x4e\x80\x9c\x96\x4f\x88\x17\xfe\x84\xc6\x50\xfa\xf1\x84\x13\x0b\x7e\xc7\x8d\x33\xd1\x95\x36\xfc\x46\xd8\x9c\xfb\xfb\x6c\x43\x0c\x4c\xe4\xb3\x01\x81\x17\x7d\x90\xb6\x74\xaa\x27\x28\x07\x79\xcb\x95\x7b\x57\xf0\x1f\xd3\x83\x9f\x83\xc6\x66\x0d\x72\x69\x74\x35\x96\xae\xeb\xc6\x9d\x03\x2f\xff\x61\xcc\xd8\x44\xcd\xf9\x04\xdf\xae\xfb\x1c\xe4\x13\x38\xca\x52\xb7\x9b\x2e\x93\x6d\xe9\xc6\x98\x4f\x60\x70\x23\x90\x84\x41\x9e\xee\x40\xa0\xef\xfd\xff\x01\x7c\xa6\x99\x6f\x06\xa5\x2f\x65\x69\xc2\x09\x3b\xc0\x22\x9a\xe3\x3d\x5a\x31\xde\x36\x76\x3e\xea\xae\x68\x8f\x3b\xcf\xd7\x6b\x90\x37\xe4\x1f\x64\xa6\xf8\xdd\xa8\xf8\x9a\xee\x35\x65\xd0\xc0\xbd\x79\x09\xbe\x89\xdd\x86\x20\x06\x75\xc3\xc7\x1c\x76\x54\xa8\xff\x79\x55\x72\xd5\xb9\x23\x82\x2c\x41\xc6\x49\x4c\xcd\xb0\x2e\xe6\x7e\x30\xe0\x02\x30\xe1\x05\x92\x90\x4d\xed\xdc\xa9\xb1\xe2\x3e\xb3\xb6\xcd\xf9\x73\xeb\x9c\xb7\xd7\x67\x16\x7b\x94\x1c\xdc\x11\x64\x10\x93\x09\x81\x2e\x9a\xb9\x14\xee\x19\xf7\x59\xab\x0e\x8c\x4d\x03\x0a\x60\x06\xfb\x84\xfe\x3a\xed\xb1\xb5\x8a\x5b\x02\x46\xc3\x70\x62\xc7\xe4\x8c\x2f\xb8\x72\x9f\xa3\x7d\x94\x2b\x3e\x18\xd9\x0d\xbc\x25\x07\xa2\x5d\xe9\x97\x06\xc2\x1d\x41\x96\x80\xbe\xe1\x1e\xe7\x5a\xdc\x1a\x31\x78\x6c\x38\xce\x40\xd8\x78\xc3\x57\xa2\xb3\x59\x17\x6d\x29\xd9\x99\x87\x10\x44\xb8\x29\xc8\xc2\x88\x86\x38\xd4\xa5\x30\x52\x57\xc7\xdc\xb8\x17\xa2\x99\x1d\x6e\xd4\x62\x21\x2b\x8b\x75\xac\xc6\x72\xa3\xde\x67\x7a\x1c\xea\x2f\xbc\xaf\x77\xdf\x5a\x47\xb8\x47\x88\xb3\x28\x0e\x70\x81\x2b\x46\xa3\x8f\x47\x1f\x2c\x50\x37\xd9\x2d\x0d\x30\xed\xaf\xf3\xaf\xe4\x16\xef\x62\x40\x76\xc8\x9f\xd4\x3d\xbb\xeb\xdd\x3b\xa2\xe1\xb6\x81\x05\x59\x44\xf1\x1f\x60\x1c\xf0\x12\x16\x8a\xfb\x70\xea\x6a\x54\x37\xf6\x47\x0e\xb5\x5e\xac\xcd\xc3\xfe\x35\x5c\x6d\x5f\x76\xf0\xff\x4b\xff\x42\x21\x9a\xd8\x4d\x08\x62\x50\xf6\x89\x5d\x14\xbe\x14\x86\x14\x7d\x27\x8d\x4f\xfd\xdd\x9c\xff\x78\xdf\xeb\x52\x7f\xa9\x84\x71\x76\xac\x1e\x50\x13\xbf\x55\x88\xd9\x94\x76\x14\x73\xe8\x0e\x6e\xdc\xbb\x89\x02\x94\x50\x3e\x6c\x29\xec\x68\x9f\xe4\xd5\x2f\xaf\xfe\x0a\x00\x00\xff\xff\x12\xcd\x84\xd3\xb3\x25\x00\x00")
+
+func dataMedicationsJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataMedicationsJson,
+		"data/medications.json",
+	)
+}
+
+func dataMedicationsJson() (*asset, error) {
+	bytes, err := dataMedicationsJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/medications.json", size: 9651, mode: os.FileMode(420), modTime: time.Unix(1444071351, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _dataPlacesJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xa5\x9d\xdb\x92\x1b\x47\x92\xa6\xef\xe7\x29\xca\x74\x3d\x03\xcb\x70\x8f\xe3\xdc\xf1\xa0\x6e\x4a\x22\x45\x9a\xc8\x69\x6d\xef\xd8\x5c\x24\xab\x40\x16\x8c\x28\x80\x8b\xaa\x12\x45\x5b\xdb\x77\xdf\x08\x22\xbe\x12\x91\xe1\x84\x34\xbb\x6d\xa6\xb6\x9f\x85\x88\x3f\xe3\xe0\x19\x07\x3f\xe5\x7f\xfe\xcb\xc5\xc5\xff\xfe\xee\x72\x73\xf7\xf9\xbb\x7f\xbf\xf8\xee\xf1\xe6\x70\xb3\xd9\xbd\xbf\x9e\x6f\xbe\xfb\xd7\x8b\xef\x6e\xef\xe6\xbb\x75\xfb\xf3\xa3\xe7\xed\x9f\x97\xfb\xfb\xdd\xb1\xd8\x8f\xeb\x77\xef\xd6\x87\xdb\xfd\xae\xfd\xf9\xe3\xfe\xe3\xfd\x76\xbe\xdb\xd4\x7f\xfd\xfb\x85\x38\x11\x4d\xf5\xaf\xed\x2f\x77\xf7\x57\xad\xba\xea\x2a\xb8\x1c\xdb\x1f\xf7\xbb\xf7\xfc\xf5\xdf\x72\x5c\x65\x37\xf9\x2f\x0c\xf5\x49\xdb\x27\xfb\xab\xf5\x6d\xfd\xe1\x3f\xbf\xd3\x20\x93\x36\xea\x06\x3c\x20\x00\x22\x20\x01\x32\xa0\x74\xe0\x1c\x40\x00\xfa\xdd\x7f\xfd\x9f\x7f\x3d\xe9\xec\x8b\xfd\xee\xee\xfd\xfe\x66\x7d\xf8\x7c\xb6\xb3\xa7\xc5\x4e\x7b\x3b\x85\x14\xfd\xa2\xb7\xb2\xd2\x18\xb3\xd1\x5b\xb5\xba\x1a\x5d\xef\x61\x05\x01\x10\x01\x09\x90\x01\xa5\x03\x37\x01\x1c\x80\x5a\x2e\x19\x5d\x7d\xbb\xd9\xae\xff\xa4\x9b\x14\x39\xe9\xa2\x2b\xa1\x3e\x62\xd1\xc5\x69\x15\x4b\xf0\x43\x17\xf3\x6a\xd2\x52\xcc\x5e\xc6\xe9\x38\x11\x15\x28\xc0\x03\x02\x20\x02\x12\x20\x03\x7a\xbf\xa3\xe3\x27\x97\x87\x5e\x3e\xab\x7d\xb9\xfd\x6d\xb3\xfd\xb3\x9e\xce\x57\x1b\x4b\x76\x5d\x9e\xea\x1c\x2c\xba\xea\x57\x49\x27\x6f\xcc\x66\xc8\xd1\x99\xb2\x5b\x59\x8e\x32\x97\x7b\x9f\x2b\x50\x40\x00\x74\x21\xce\x7d\x1e\x2b\xa0\x56\x9d\xc7\x65\xc7\x1e\xed\x2e\xaf\xf7\x87\xf9\xfd\xa2\x5f\x3f\x9d\xf6\xeb\xa4\xd4\xa9\x9c\x16\x97\x25\x9e\xf6\x2c\xba\x95\xb8\xec\x96\x3d\x73\xbe\xac\xca\x34\x59\xb2\x5a\x4a\x38\x76\xad\x01\x01\x28\xc0\x03\x12\x20\x77\xe0\x02\x20\x02\x28\x63\xcc\xe2\xdf\xe6\xcd\xe1\xed\xbc\xfb\x70\x7b\xb6\xb3\x0f\xa5\x2e\x7e\xde\x1f\xee\xae\x2f\x5e\xdf\xcd\x87\xa1\xdf\xea\x82\x2e\x26\x34\xfa\x55\xd6\x94\xc7\x6e\xa7\x55\x72\xd1\x9b\xdd\x4e\x74\x3b\x1d\xc5\xb0\x82\xba\xae\x2c\xdb\xfd\xe3\xfd\x6e\x3d\xdf\x9f\x6d\xf4\x1f\x45\x16\xcd\x94\xb4\x68\x66\xc8\x2b\x9d\x5c\x19\x9a\x59\xe5\xd1\xbb\x92\xcc\x66\x66\x9a\x99\x99\x9d\x26\x78\xcb\x66\xbe\xba\xde\xaf\x77\x9b\xdf\x4f\xdb\xf9\x3f\x97\x6f\xc8\x61\x73\xb9\xff\x38\x8f\xaf\x88\xf7\x21\xaa\x0c\xeb\xbb\xf7\x79\x78\x47\x9c\x93\xd5\x94\xac\x11\xcd\x61\x3a\x8a\x4d\x03\x1e\x10\x01\x09\x90\x3b\x38\x2e\xe2\x0d\x50\xcb\x51\xeb\x28\x5a\x0d\x50\xdd\x51\xdd\x3d\x54\x2f\x1d\xc8\x04\x70\x00\x98\x05\x66\xe5\x27\xe5\x27\x7d\xf8\x89\x87\x2a\x0f\xf5\x10\x7a\x6a\x79\x6a\x79\x6a\x79\x6a\x79\xda\x13\xa8\x15\xa8\x15\x28\x1c\xfc\x30\x5f\x6f\xee\x2f\xfb\x62\xf5\xcd\xe9\x7a\xb5\xb9\x19\xa7\xaa\xee\x86\x75\x4b\x18\xf6\x26\x11\x89\xe3\x4c\x4d\xab\x92\x7c\x32\xa7\xaa\x0b\x7f\x03\x1e\xd0\xfb\x9f\xdc\x04\xa0\x0c\x53\x95\x98\xaa\xc4\x54\x25\xf7\x50\x2b\x02\x32\xa0\xcf\x50\x5d\x6a\x3b\xf0\x14\xf6\x14\xf6\x09\x40\x2d\x4f\xad\x3a\xa0\xc3\x22\xb2\x9d\xdf\xd7\x9e\xbc\x7b\x77\x76\xe0\x9e\xec\x2f\xf7\xbb\xcd\x6e\x3f\x0c\x5e\x0c\x39\x4d\x8b\xb1\x0b\x2b\x57\xb2\x8e\x63\xe7\x56\x31\x38\x6b\xbd\xcc\x71\xea\x63\x17\x11\xf3\x0a\xc2\xd0\xd6\xe7\x9b\xbb\xbb\xed\xfa\xe2\x97\xfd\xe5\x87\xd3\xd6\xfe\xb2\x98\xe6\xda\xbe\xdb\x0f\x1b\x63\x8b\xae\xe7\x1b\x3f\xee\x5b\x3e\x86\x65\x6b\x4b\x15\x80\x5c\xa2\xd1\xd8\x24\x72\x6c\x6c\x03\x02\xf0\x80\x00\x48\x80\xd2\xc1\x51\x06\x1a\xa0\xba\xa3\xfa\xf1\xa5\x6a\x20\x19\xab\xfc\xe7\xf5\xdd\xdd\xda\xd8\xad\x17\xbd\xfe\x75\xbe\xbd\xae\x47\xd2\x3b\x63\xc3\x4e\x6d\x23\x5d\xf4\x3b\xae\xa6\x38\x4a\x78\xf1\x2b\x17\xcc\xb5\x28\x49\xa2\xdf\x69\x52\xc0\xf8\x1a\x3e\xdf\xdf\x5e\x3c\xda\xbd\x5f\x6f\xd7\xa7\xfb\xd2\x93\x47\xa7\xcd\x5d\x94\x3b\x5d\xe7\x53\x91\x28\x6e\x98\xa9\x29\x88\x8c\x72\x95\x57\xe2\xd5\x5c\xe9\xa7\x2e\x4e\x0d\x04\x40\x04\x24\x40\xee\xe0\x38\x43\x0d\x38\x80\x00\x14\x00\xa1\x83\xd0\x41\xe8\x20\x74\x0f\x84\xa5\x03\x81\x59\xa8\x2e\x54\x17\xaa\x0b\xd5\x85\xea\x42\x75\xa5\x3d\x4a\x7b\x94\xf6\x28\x84\x0a\xa1\x42\xa8\x10\x2a\x84\x0a\xa1\x87\xd0\x43\xe8\x21\xf4\x10\x7a\x08\x3d\x84\x1e\x42\x0f\xa1\x87\x30\xf0\x53\xa4\x7a\xa4\x7a\xa4\x7a\xa4\x56\x1a\xa5\xfc\xf5\xbc\xbb\xf8\xdb\x61\xde\x5d\x6e\x6e\x2f\xf7\x67\xe5\x66\x28\x79\x22\x39\xf5\xe0\x28\xcb\x93\x8c\xd6\x13\x4b\x5d\x03\x07\xc1\x11\x69\x47\x04\xf3\x24\xe3\x5d\x3f\x19\x54\xa0\x00\x0f\x08\x80\x04\xc8\x80\xd2\x41\x17\x25\xdf\x6f\x1d\x0d\x40\xe8\xe0\x71\xf0\x74\x09\xf2\xce\x41\xe8\x20\x14\xaa\x0b\xd5\x85\xf6\x08\x3c\x42\x2d\xa5\xb0\x52\x58\x29\xac\xde\x1c\xf2\xa7\x9b\xf5\xfb\x3f\x1f\xee\x87\x52\xa7\xab\xa9\xd6\x43\xcb\x24\xc3\xc6\x59\xf7\xaf\x34\xbe\xa4\x69\xe5\xa2\xb3\xee\x01\xa5\x2e\x8c\xc7\x76\x0b\x83\x2e\x0c\xba\x30\xe8\xc2\xa0\x4b\xbf\xf3\x35\x90\x00\x19\x50\x3a\xe8\xa3\x2f\x8c\x7e\x05\x10\x3a\x08\x1d\x84\x0e\x42\x07\xa1\x83\x47\xe0\x11\x78\x84\x16\x0a\x84\x02\xa1\xc0\x23\xf0\x08\x0d\x13\x08\x15\x42\x85\xb0\xbe\x92\xe3\xc4\x5c\x1e\xe6\x9b\xf5\xee\xee\xcf\x66\xe6\xeb\x62\x27\x53\xe3\x63\xf4\x39\x2f\x66\x26\xaf\xda\x95\x69\x7c\x0b\xdc\xca\x17\x6f\xbe\x05\xdc\xb5\x1a\xf0\x80\x00\x88\x80\x04\xc8\x80\xd2\x41\x1f\xbf\x0a\xe0\xe9\xe3\x57\x81\x02\x60\x16\x98\x05\x66\x81\x50\xa9\xae\x54\x57\xaa\x2b\xd5\x95\xea\x6a\xdc\x94\x0e\xeb\xdb\xdd\xf9\xd1\xfc\xa3\xc8\xe9\x48\x96\x3a\x96\x61\xd8\x3a\x93\x75\x33\x72\x65\x95\x72\x32\x65\x5c\xb9\x19\x55\x20\x00\x05\x78\x40\x00\xc4\x0e\xba\x20\x6b\x3f\x37\x56\x20\xfc\x45\x1e\xfe\x02\xa1\x50\xab\x0b\x60\x05\xe3\x50\x3c\x5e\xff\xb6\x3e\x6c\x3f\x5f\x3c\xab\xe7\x89\xff\x8f\x0d\xba\x2d\x75\xe3\xf6\x9c\x34\x5a\xdb\xb3\x9f\x4c\xed\x55\x99\x84\x1d\x57\xd8\x71\xc5\xb8\x2f\x3e\x5d\xef\x6a\x9b\x4f\xdb\xfa\xf2\xb4\xad\x7f\x14\x39\x3d\x9d\xb6\x6d\x7a\xf9\x1e\xd4\x59\xd2\x32\x1e\x23\x26\xbf\x2a\xc5\xbc\xce\xd7\x5b\xe2\x71\xd2\x1a\x50\x80\x07\x04\x40\x04\x24\x40\xe9\xa0\x1f\xff\xe9\x66\x9e\xfa\xd1\xaf\x81\x0c\xa0\xb0\x50\x58\x78\x96\xf0\x2c\xe5\x27\x85\x47\x79\xa8\xf2\x50\x85\x50\x21\xf4\x94\xf1\xe3\x5a\xf3\x64\xbf\xdd\x1f\xe6\xab\xfd\xc5\xeb\x8f\x87\x7a\x78\xbc\x3d\x3b\xca\xdf\x6f\x2f\x5e\xcd\xb7\xc6\x4b\xe2\xa2\x97\x34\x2c\x37\xf5\x25\x2d\xd6\x30\xd7\xd5\xc0\xbc\xec\x4e\x85\xd1\x2d\x8c\x6e\x61\x74\x0b\xa3\x5b\x18\xdd\xc2\xe8\x16\x46\xb7\x38\x0a\x3b\x0a\x3b\x0a\x33\xcc\x85\x61\x2e\x0c\x73\x91\x51\xe2\x1e\xef\xef\xb7\x57\x7f\x22\x72\x5f\x95\x39\x19\x8c\x92\x34\x2f\x16\x0c\x3f\xad\xaa\x1c\x8e\x43\x11\x56\xf5\x04\x1d\xcc\xa1\xd0\x7e\x21\xaa\x40\x00\x0a\xf0\x80\xf1\x8a\xf4\x6c\x3e\xdc\xbd\xdb\x1f\xae\x4e\x9b\xfe\xe6\xb4\xe9\x5f\x17\x3a\xdd\xd2\xc5\xa7\xa5\x82\xc5\xbb\x55\x8a\x61\x58\xed\x92\xac\x62\x52\x6b\x1e\xa7\xd8\xb7\xef\x09\x4d\xed\x84\xa6\xb6\x82\xa3\xe0\x37\xe0\x3b\x90\xf1\x52\xfa\xf3\xfa\xd3\xc5\xb3\xf9\xb7\xf5\xee\x6c\x37\x4e\x4a\x2d\xfa\x51\x52\x2a\x43\x3f\x74\x1a\xaf\xa5\xb5\x1f\x45\x52\x31\xfb\x11\x8e\x92\xd5\x80\x03\x28\x20\x00\x8c\x0d\xfc\x6e\xbe\xf9\xd3\x49\x68\x7a\xb9\x77\x9b\xf5\xd6\x9a\x05\x89\x5e\x87\xd6\x4f\x41\x07\xd5\x51\xd2\x55\xd0\x9c\xcc\xd6\x97\xa9\x37\xba\x4c\x02\x50\x40\x00\x44\xc0\x78\x26\xff\x75\xb3\xbd\xf9\xe3\x42\xf9\xd0\x91\xa7\xdf\x8f\xd3\xf0\x64\xbe\xbd\x33\x74\xe2\x69\xca\xc1\x59\xcb\xef\xa0\x4d\x4d\xa1\x76\xa4\x64\xa3\x23\x0e\x75\x9d\x43\x5d\xd7\x40\x00\x8c\x3a\xe0\xa7\xfb\xe5\x6e\xb1\x6c\xf1\x4f\xf5\xd0\x34\x6e\x69\x71\xf2\x69\x68\x6b\xdd\x3e\xc4\x6a\xab\x98\x27\x26\x57\x0a\x6d\x2d\xc6\xd5\xf8\xf4\x86\xfe\x47\xf3\x9e\x2c\x36\xb3\xcd\xed\xdd\x61\x73\x79\x77\xb1\x7f\x77\x51\xd7\xe7\xfb\x9b\xb7\x9b\xd9\xd8\xda\x5c\x12\x1d\xd6\xdc\x3a\x8f\x63\x7b\xd3\xaa\x5e\x0a\x2d\x11\x97\xa9\x2b\x5e\x1a\x10\x80\x02\x3c\x20\x00\x22\x20\x01\x32\xa0\x74\x70\x7c\x67\x84\x4b\xb4\x70\x89\x16\xae\xcc\xc2\x95\x59\xb8\x32\x0b\x57\x66\xe1\xca\x2c\x5c\x99\x85\x2b\xb3\x70\xf9\x15\x6e\xb8\xf2\xe5\x86\x3b\x18\x70\x36\xf3\xcd\xe6\x64\x78\xff\xb6\xb4\x6a\xb4\x12\xff\xf6\x74\xbe\x1a\xe5\x55\x4b\xf1\x61\x21\x04\x12\xea\xfa\xe7\x86\x1b\x4d\x9e\x56\xae\xee\x2a\x96\x61\x43\xdd\xf1\x28\xdb\x40\x04\x24\x40\x06\x94\x0e\x8e\x9b\x7a\x03\x0e\x20\x00\x05\x40\xa8\x10\x2a\x84\x0a\xa1\xa7\x96\xa7\xb0\xa1\xee\x7b\x79\xd8\xce\xbb\xab\xfd\xd9\x01\x7a\x59\x6f\xd7\x96\x6d\x44\xb3\x4e\x0b\x2d\x92\xe4\xb6\xfe\x0c\x8b\x6a\xae\x6b\x6d\xfa\x72\xc6\x1a\x06\x47\xb0\xfa\x08\xc6\x9e\x0a\x3c\x20\x00\x22\x20\x01\x32\xa0\x74\xe0\xe0\xe9\xa6\x4b\xe9\x97\x94\x06\x28\x23\xfc\x24\xfc\x24\x10\x0a\x65\x94\x87\x1a\x57\xb1\x37\xf3\xcd\x51\xb9\xff\xcd\xc1\xfa\x72\x8e\x7e\xbb\x3f\xec\xef\xdf\x5f\x8f\xf2\xa4\x21\x2d\xcf\xc9\x92\x56\x25\x4c\xa3\x91\x57\x56\x55\xf4\xcc\x21\x53\x6c\x82\x8a\x4d\x50\xb1\x09\x2a\x36\x41\xc5\x26\xa8\xd8\x04\x15\x53\x60\x33\x86\x02\x1c\x00\x42\x07\xa1\x83\xd0\x41\xe8\x20\x74\x10\xba\x0c\x80\x19\x21\x8e\x7e\x7c\x13\x7f\x9c\x2f\x3f\xdc\xee\x77\xa3\xe2\x72\x39\x84\x4f\xef\x7f\x9b\xb7\xa3\x26\x47\x5c\xca\x7e\xb0\xa7\xaa\x8e\x2a\xc0\xdc\x34\xcb\x21\x99\xe2\xd6\x8f\xee\x8a\x8e\x56\xd1\xd1\x36\x10\x01\x09\x90\x01\x5d\x3a\xfa\xd1\x5d\xd1\xda\x36\x40\x2d\x47\x2d\x47\x2d\xe4\xae\x9f\xe1\x1b\xa0\x16\x92\xd8\x4f\xf5\x0d\xd0\x1e\xa1\x3d\x02\xb3\xe7\x27\xcf\x5f\x02\x7f\x09\x0f\x7f\xe1\xe9\x81\xa7\x1b\x6a\xb5\x37\xf3\x76\x3b\x5f\xcf\xb7\xb7\xeb\xf3\x53\xf0\x7c\x6d\x9a\x79\xeb\x2a\x13\x87\x19\xf0\xd6\x0b\xef\x57\xed\x5d\x35\x67\x40\x79\xe1\x95\x17\x5e\x99\x0a\x65\x2a\x94\x81\x57\x06\x5e\x19\x78\x65\xe0\x95\xf7\x5c\x0d\xbb\xfd\xa3\xbb\xba\xae\xdd\x9d\xbe\xaa\x7f\x5f\x5e\xf1\xef\xb7\x96\x72\xdc\x7f\xd9\xf3\x06\x4b\x9d\xa1\x31\xac\xbd\xd4\x6c\x2e\xf9\x9c\xce\x75\xa2\x4b\x15\x44\x40\x02\x64\x40\xe9\xa0\x77\x72\xa2\x93\x13\x9d\xac\x00\xc2\xfe\x66\x56\x00\xb3\x83\xd9\x41\x28\x94\x11\x7e\x12\x1e\xaa\x30\x7b\x98\x03\x85\xa3\x1a\xba\xa7\xdf\xe6\xdd\x6e\xbe\x3e\x3b\x90\x4f\xae\xe7\xbb\xee\xf9\xb2\xd0\x08\x46\xc9\xa3\x25\x6d\xca\x53\x31\x5e\xd9\x7a\x17\x33\x97\x3b\xe7\xbb\xc0\x54\xe0\x01\x01\x10\x3b\x70\xfc\xc5\x38\x7f\x3f\xba\xbb\x5e\xef\x6e\xcf\x77\x61\x3b\x1f\x3e\x18\x4e\x1c\x2e\xf8\x30\x5a\x6d\x4b\x18\x2d\xcc\x59\x57\x75\x75\x8f\xa6\x34\xc4\xde\x83\x89\xe5\x79\x62\x79\x9e\xa2\x71\xd0\x7e\xb6\xdf\xd5\x63\xde\xf6\xd4\x24\xfe\xec\x87\xc5\x46\xf3\x55\xa1\xc5\x26\x93\xea\xaa\xb0\xd8\x64\xda\x65\x27\x8e\xb7\xef\x90\x56\x39\x64\xd3\x67\x21\xe6\xae\x23\x8d\x28\xfb\x22\xca\xbe\x88\xb2\x2f\xa2\xec\x8b\x28\xfb\x22\xca\xbe\x88\x8e\x2f\xa2\xe3\x8b\x4d\x91\x37\x74\x75\xb3\xdd\x9f\xef\xe6\xfc\x69\xde\x8c\xa6\x3b\x5f\x25\x7b\xf1\x8e\x36\x75\xdb\xd1\x6c\xbe\xe8\x63\xa8\x12\x97\x83\xd9\xc7\x64\x5c\x36\x1f\xef\x37\xb7\xa7\xab\xe3\x0f\x4f\x17\xfe\x22\x57\xb3\xe5\xd1\x14\xd3\xe2\x6e\xe3\x75\x15\x8d\x7b\xbe\x8b\xab\xba\x0b\x99\x9a\x25\xd4\x81\x19\x75\x60\x46\x1d\x98\x51\x07\x66\xd4\x81\x0d\x94\x0e\x1c\x85\x1d\x85\x0d\x67\x98\x1f\xae\xe6\xeb\xfd\xc5\xdf\xe6\xa5\xa2\x6f\xd9\xbd\xc7\xfb\xdd\xee\x0f\xfb\xe2\xa9\x6d\xbc\x49\xc5\xd0\x49\x5f\x5c\x32\x9d\x18\x8e\x0a\x9f\xb1\x97\xfd\x8d\x6e\x40\x00\x1e\x30\x36\xfc\xc9\xf5\xe6\x72\x5e\xd8\x25\x7e\x78\xbe\xb4\x48\xef\x3f\x8c\x93\x12\x4b\x08\x25\x0f\x57\xe7\x9c\x46\xff\x9d\x9c\x56\xf5\xf8\x62\xad\xe6\x91\xf7\xb7\x01\x01\x28\xc0\x03\x02\x20\x02\x12\x20\x03\x4a\x07\xc7\x85\xbe\x01\x98\x1d\xcc\x0e\x66\x07\xb3\x83\xd9\xc1\xec\x60\x76\x30\x3b\x98\x05\x66\x81\x59\x60\x16\x98\x05\x66\x81\x59\x60\x16\x08\x05\x42\x85\x50\x21\x54\x08\x15\x1e\xa5\xba\xd2\x30\x85\x47\xe1\xf1\xf0\x78\x78\x3c\xed\xf1\xf0\x78\xda\xe3\x21\xf4\x10\x7a\x78\x02\xd5\x03\xcd\x08\xf0\x04\xaa\x07\xaa\x07\xaa\x07\xaa\x47\x9a\x11\xdd\xb8\xdb\x7d\x51\x7a\x3e\x68\x61\xbe\x29\x6c\xaf\xeb\x85\x68\xbe\xb1\x8e\x48\xae\x0e\xe9\x34\x6a\x38\xb2\x71\x61\x2c\xf5\x90\x3a\x59\xd6\x81\x88\x65\xbd\x01\x01\x28\xc0\x03\x52\x07\x8e\xc2\x86\xae\xfc\xd5\x7a\x7f\xd8\xcc\x67\x3b\xf3\x47\x91\xe5\xd6\x37\x4d\x69\x50\x5c\xc6\x32\xea\xf4\x6b\x57\x42\xb6\xde\xf4\x2a\xb0\xbd\x03\x8e\x17\xc6\xf1\xc2\x38\x5e\x18\xc7\x0b\xe3\x90\x78\xd7\x24\x7e\x58\xbd\x76\x57\x9b\x79\x37\x7f\xdc\x6f\x37\x8b\xe5\xeb\xe7\xd1\x07\xcb\x98\x9a\x5c\x0f\xfc\x3e\x8c\x53\x13\xb3\xe5\xa4\xe8\x42\xb6\xa6\xc6\xc7\xee\xec\xd1\x80\x00\x14\xe0\x01\x01\x90\x3b\x70\xfc\x74\x7c\x85\x1b\x48\x80\x87\x32\xa5\x83\xe3\x2b\xdc\x00\xcf\x12\x9e\x25\xf0\x08\x8f\x10\x08\x05\x42\x81\x50\x20\x54\x78\x94\xea\x4a\x75\xa5\xba\x52\x5d\xa9\xe5\x69\x86\xa7\x7a\xe0\x2f\x01\x9e\x40\xf5\x40\xad\x48\x99\x48\x33\x92\x61\x80\xdb\x1f\xee\x2e\x7e\x9d\x3f\xef\xd6\x67\xa7\xf2\x51\xdd\x83\x0c\x57\xe9\xa0\xb1\xb8\x51\x1f\x9a\xca\x78\x0f\x09\x2b\xa7\xa6\x49\xdf\xc7\xcc\x04\x66\x26\x30\x33\x81\x99\x09\xec\x8a\x87\x06\x12\x20\x03\x7a\x8f\x33\x73\xdb\x8f\x47\x9e\xe3\x91\xe7\x54\xe4\x39\x15\x55\xc0\xbc\x65\x66\x20\xfb\x51\xd6\x5f\xef\xef\xef\xae\x2f\x1e\xaf\x77\x57\x67\x87\xe7\xf5\xdd\xea\xe2\xc7\xfd\xed\xfa\xe3\xa8\x69\x70\xcd\x87\x6d\xdc\xf8\x62\xf7\xba\x5e\x48\xbb\x04\x31\x87\x28\x22\xec\x7d\x53\x6a\xc0\x03\x02\x20\x02\x12\xa0\x77\x36\x22\x89\x91\xce\x46\x43\x43\xf7\x74\x7d\x7b\xf1\x62\xbf\xd9\x2d\xfc\x83\x7e\x58\x9c\xd0\x5f\xed\xb7\xc6\x06\x5f\xaf\x31\x3a\xaa\xc6\x43\x1e\xfd\xc8\x4b\x3d\x8c\x89\x75\x0a\x0c\xdc\xbf\x02\xf7\xaf\xc0\xfd\x2b\x70\xff\x0a\xdc\xbf\x02\xf7\xaf\xc0\xfd\x2b\x70\xff\x6a\x20\x75\x20\xf0\xc8\xb8\xc9\x3c\x59\x5f\xcd\x87\x8b\x5f\xe6\x8f\x9b\xab\xf3\x3d\x7e\xbe\xd9\x59\xa6\x8c\xba\xfd\xc6\xa1\xc7\xd8\x37\x4e\x7a\x5c\xe7\x3b\x06\xeb\x46\x12\xa4\x9f\xc0\x1a\x10\x80\x02\x3c\xc0\x58\x85\xf7\x9f\xe6\x8b\x27\xed\x1f\xe7\x5a\xfe\xe3\xfe\x7a\x67\x39\x8a\xc7\x94\xa3\x8c\x42\x79\x74\x04\x59\xb6\x3d\xe8\x24\x66\xdb\xfb\xc2\xd4\x40\x00\x44\xc3\x5a\x71\x79\xbd\x59\x68\x00\x7e\x7a\xbd\x78\x81\xd6\x57\xef\x3f\x6d\x2e\x47\xb9\xd2\x5c\x17\xc6\x3c\xb8\x0d\xc5\x3c\x6a\xd3\x4b\x5a\xa9\xda\xfb\x78\xea\x7b\x44\x03\x0a\xf0\x80\x00\x88\x80\x04\xc8\x80\xd2\x41\x3f\x2e\xa6\xae\x75\x6a\x00\x66\x07\xb3\x83\xd9\xc1\xec\x60\x76\x30\x3b\x98\x1d\xcc\x02\xb3\x50\x58\xf9\x8b\x8e\xd3\xff\x66\xff\x71\xfd\xe1\x4f\xc6\xf4\x7a\xfe\xb4\x5b\x1b\x57\x69\x49\x3e\xe9\xb0\xff\x4e\xfd\x8f\x27\x43\x1a\xea\x42\x65\x2e\x49\x91\x30\x88\x48\x18\x44\x24\x0c\x22\x12\x06\x11\x09\x83\x88\x84\x41\x44\xc2\x20\x2a\xe8\x23\x19\x39\x78\x47\x0e\xde\x2c\x6d\x91\xa5\x2d\xb2\xb4\x45\x96\xb6\x18\x39\x6f\x7f\x59\xe3\x06\x4f\xc7\xf9\xd3\x61\xbd\xbb\x5c\x9f\x1d\x9e\xa7\xfb\xfb\xf7\xdb\x79\xf4\xa0\xc8\x69\xb0\xf1\x35\xf3\x4d\x1a\x0f\x8e\x75\x74\xea\xd4\xa8\x39\x3a\xdd\x5b\xaf\x81\x00\x88\x80\x04\x30\x9a\xbe\xbf\xdf\x18\x01\x20\x3f\xfd\xf3\xaf\x86\x2f\x85\x92\x54\x47\x93\x7f\xdd\x58\x92\xb1\x27\xa7\x60\xde\xc7\x3d\x8e\x15\x1e\xc7\x0a\x8f\x63\x85\xc7\xb1\xc2\xe3\x58\xe1\x71\xac\x68\x20\x03\x4a\x07\xc7\x69\xf6\x78\x58\x78\x3c\x2c\x1a\x80\xd9\xc1\xec\x60\x76\x30\x3b\x98\x1d\xcc\x0e\x66\x81\x59\x20\x14\x08\x85\xc2\x42\x61\xcf\xd3\x3d\x85\x3d\x85\x3d\x0f\x0d\xd4\x4a\x94\x29\xd4\x2a\xc6\x4c\xad\x7f\x37\x4c\x86\xcb\x89\xea\x4e\xc2\x46\x3c\x4b\x8b\xa9\x19\xa6\x69\xf2\x86\x01\xc2\xaf\xea\xfe\x95\xcc\x69\x0a\x4c\x53\x60\x9a\x02\xd3\x14\x98\xa6\xc0\xec\x04\x66\x27\x30\x3b\x81\xd9\x09\xcc\x4e\x60\x52\x02\x93\x12\x98\x94\xc0\xa4\x04\x43\xcd\xdb\x6c\xcd\x2f\x0f\xdb\xf5\xbc\x50\xef\x3d\x7f\xb4\x34\x61\x3d\x94\x59\xf8\x2d\xd5\x95\x7e\x69\x90\x29\x4d\xbf\x37\xee\x48\x53\x3d\x61\x3a\x4b\x6c\xeb\x6d\xad\x7b\x6e\x4f\xdd\xaf\xb0\x01\x0f\x08\x80\x08\x48\x80\x0c\x28\x1d\x08\x3c\x42\x75\xa1\xba\x50\x5d\xa8\x2e\x54\x17\xaa\x1f\x97\xee\x06\xc6\x63\xc7\xe3\xb9\x0a\xcd\xc5\x2f\x75\xf9\x59\x9f\x1d\xaa\xef\xe7\xdb\xbb\x8b\x45\xe1\x53\x19\x92\xe2\x8b\x8e\x66\x80\x30\xaa\xba\xea\x2e\xee\x6c\x0f\xb8\x34\x75\xbb\x5f\x03\x02\x08\x80\x08\x48\x80\x0c\xe8\x7d\xed\xf1\x5f\x0d\xc0\xc3\x24\x64\xc6\x3e\x33\xf6\x99\xb1\xcf\x8c\x7d\x66\xec\x33\x63\x9f\x0d\x55\xe0\xeb\xeb\xc3\xfa\xb7\xf5\xc7\x7a\x59\x39\x3b\x64\x4f\xe6\xab\x2b\xc3\x1f\xb6\x14\x1d\x02\x00\x65\x15\x8e\x87\xed\xe5\xd9\x34\x05\xf3\xb4\x53\x2f\xf7\x7d\x9c\x5c\xf7\xa2\x69\xc0\x03\x02\x20\x02\x12\x20\x03\x4a\x07\x8e\xc2\x8e\x9f\x1c\x3f\xc9\xb8\xce\xbc\xaa\x9d\x6e\xa6\xe1\x93\x8e\xbf\x58\x38\x4e\x3c\xb9\xbf\x79\xbb\x3e\x50\xec\xf4\xa8\x17\x8f\xae\xd4\x0b\x75\x68\x30\x5c\x3d\xa6\xba\x4d\x44\x4b\xf1\x36\xf9\xde\xf9\x09\xff\xeb\xe9\x8b\xff\xf5\x28\xda\xbb\xf7\xfb\xc3\xd9\x96\xbe\x5a\xef\xf6\x6f\x6f\x2f\xf7\x86\x9f\x87\x4e\xba\x74\x0d\xf2\xab\x2a\x9d\xc3\x8a\x18\xf3\x2a\x25\xd3\x46\x51\xb7\xdd\xc9\x7a\xe3\xb6\x77\x9b\x9b\xfd\xe1\xf4\x7d\x7b\xb1\xd4\xb6\x52\xea\xe1\x58\x7d\x3a\x8e\x75\x4f\x8b\xa3\xcf\x8c\x94\x31\xb6\x32\xc5\x55\x3d\xcc\x58\xed\x6b\x9e\xcb\x47\xef\x0b\xd7\x77\xd8\x06\x02\x20\x02\x12\xa0\x74\xd0\x9d\x37\x5c\xdf\x46\x1b\xa0\xfa\x71\xa5\x6b\xc0\x03\x20\x74\x10\x3a\x08\xbb\x3b\x87\xeb\x7b\x65\x03\xd4\x12\x6a\x09\xb5\x84\xa7\x2b\x4f\x57\x9e\x6e\x98\xe4\x1f\xed\x2c\xa5\xd0\x72\x94\x6b\xa9\xf5\xc5\xa3\xc3\xfd\xee\x6a\xbd\x35\x4e\xfb\xba\x94\xd5\x2f\x67\xaf\x6c\x0d\xb1\x2f\xdf\x18\x62\xcf\x10\xf7\x9b\x54\x03\x63\x73\x5b\xb0\xd2\x78\xd0\x5a\x36\xf7\x4c\xe8\x74\x73\x90\x2b\xe3\x31\xda\x68\x6b\x5a\xb9\x20\xd9\x74\xf3\xc9\xa1\x7b\xd3\xe4\xe0\x00\x02\x30\x5e\xb0\x4a\xb0\x38\x71\xbc\x58\x7a\x9f\xdf\xbf\x7b\x67\xdd\xd0\xeb\xd1\x39\x0c\x0b\x41\x8b\xf4\x9e\xc6\x85\xa0\xf9\xb4\x99\x7a\xc4\x09\xa7\xfe\x09\xa7\xfe\x09\xa7\xfe\x09\xa7\xfe\x09\xa7\xfe\x09\xa7\xfe\x09\xa7\xfe\x09\xa7\xfe\x06\xe0\x71\xf0\x08\x3c\x02\xcf\x71\x17\x9e\xf0\xe5\x6f\x00\x1e\x81\xe7\xb8\x0b\x4f\x38\xf5\x4f\x38\xf5\x4f\x38\xf5\x4f\x38\xf5\x37\x00\xa1\x42\xa8\x10\x2a\x84\xa5\x57\x17\xfa\x25\x86\x12\xf4\xd7\xfd\xe1\x72\x7d\x7b\xb7\xf0\x67\x5b\xce\xc5\x49\xa9\xa5\x09\x7f\x5a\x2a\x41\xeb\x6c\x88\x11\xf9\x55\x67\x23\xdb\x56\xaa\x09\xad\xee\x84\x56\x77\x42\xab\x3b\xa1\xd5\x9d\xd0\xea\x36\x90\x00\x19\x50\x3a\x70\xd3\x5f\xd6\xc1\x2f\x3b\xf9\x6c\xbe\xf9\x78\x65\xf9\x7a\x86\x66\x54\x1d\xba\xe8\x0c\x8f\xdb\x24\x4d\x6f\x6d\xee\x3c\xec\xb6\x13\xbb\xed\xc4\x6e\x3b\xb1\xc9\x4e\x6c\xb2\x13\x9b\xec\xc4\xde\x3a\xb1\xb7\xb6\x93\x21\x7f\x31\x36\xd9\x27\xf3\xcd\xdb\xc3\xe6\x6a\x71\x22\x5b\xf6\xf4\xc5\xe6\xea\x6a\xbb\xbe\x5d\xff\x6e\x68\xf9\xea\xe9\x58\xc6\x97\xcb\xf0\xbb\xaf\xd3\x59\x5b\x99\xec\x97\x4b\x91\x61\x45\x86\x3d\x32\xec\x91\x61\x6f\xb8\x45\xaf\xaf\x06\xb7\xd6\xff\x46\xe3\x55\x65\x9c\x27\x5f\x26\xab\xed\x62\x5a\xd6\x27\xd7\x02\x5f\x47\x85\xe2\xdd\x61\xbf\x39\x3d\xb2\xbd\xf8\x61\x19\x1c\xd9\x75\xcf\xa7\xfe\xa9\x4e\x53\x4a\xe3\x78\xaa\xf3\x86\xb9\xbf\xbe\x49\x96\xec\xf8\x8c\x8d\x20\x73\x9d\xcd\x5c\x67\x33\xd7\xd9\xcc\x75\x36\x73\x9d\xcd\x5c\x67\x33\xd7\xd9\xcc\x75\x36\x73\x9d\xcd\x5c\x67\x33\xd7\xd9\xcc\x75\x36\x73\x9d\xcd\x5c\x67\x33\xd7\xd9\xcc\x75\x36\x73\x8b\xcd\x58\x16\x32\x97\xd7\x8c\x65\x21\x63\x50\xc8\x18\x14\x32\xf7\xda\x8c\xf9\x20\x63\x3e\xc8\x62\x04\xd7\xfc\xfd\x50\xcf\x83\x96\x7e\x73\x39\x07\xa6\xdb\xad\xcb\xb9\xde\x46\x87\x29\x28\x71\x74\x76\xce\x61\x15\xa3\x6d\xa6\x21\xbd\x82\x27\xbd\x42\x03\x01\x10\x01\x09\x90\x3b\x10\xca\x28\xb5\xfc\x03\xa0\x96\xcf\xd6\x19\xa4\x1e\x2e\xde\x2e\xcf\xa0\x3f\x8c\x01\xb9\x77\xeb\xdd\xfc\xc9\xb0\xb5\x69\xd1\x71\x93\x6c\x5e\x8e\x86\xdc\x25\xaf\xa6\xd8\x39\x84\xcc\x21\x64\x0e\x21\x73\x88\x94\x9b\x2c\xbd\xd5\xee\xb6\x2e\xb9\x67\xdb\xfe\xc3\x43\x6e\x9b\x45\xc3\xbd\x94\xf1\x85\x49\x2a\xc1\xd2\x27\xd4\xff\x99\x2d\x2f\xbc\x03\x05\x41\x2f\x08\x7a\x41\xd0\x0b\x62\x5d\xd4\xc8\x48\xb3\xa9\xe7\x3b\xeb\x18\xb8\xb0\x98\x3c\x5b\xd7\x72\x1f\x37\x3b\x4b\xe1\x1b\x52\x1e\xae\x01\x25\x25\xcb\x90\x20\xd1\x34\x24\x04\xb4\xea\x01\xad\x7a\x40\xab\x1e\xd0\xaa\x87\xee\xbb\xd4\x40\x04\x24\x40\x06\x94\x0e\xba\x41\xa2\x02\x98\x1d\xcc\x0e\x66\x07\xb3\x83\xd9\x41\xe8\x20\x74\x10\x06\x0a\x07\xc3\xec\x34\x6f\x76\x77\x17\xaf\xe6\xfb\xed\xd9\x41\xfc\x65\xbe\xb9\x5d\x1b\x39\x7d\xea\xa1\x32\x1a\x43\x18\x34\x19\x43\x38\x15\x73\x04\x1d\x23\xe8\x18\x41\xc7\x08\x3a\x46\xd0\x31\x82\x8e\x11\x74\x8c\xa0\x63\x04\xfb\x81\xaf\x01\x7e\x62\x08\x9c\xb5\x65\xdc\x6f\xef\xef\xae\xcf\xf6\xba\x19\xdb\xbe\xa8\x49\x47\xd5\x6d\x94\x65\x48\xa0\x8f\xab\x94\x63\x32\xb2\x08\xb8\x69\xb2\x65\x27\xd3\xe1\x4c\x87\x33\x1d\xce\x74\x38\xd3\xe1\x4c\x87\x33\x1d\xce\x08\x48\x36\xbc\x00\xea\xa5\xe3\xda\x38\x36\x2e\x7a\xf8\x72\x7b\x53\xcb\x18\xf1\x27\x53\x4c\x71\xbc\x22\x4f\x4e\x9c\xd1\x41\x9f\x6d\xb3\x4d\x28\x4c\x6d\xa1\xa7\x85\x0e\x16\xc3\xe9\xa7\xbb\x0b\x9f\xb6\xf8\xf5\xd2\xd9\x7a\x77\x35\xce\x47\x3d\x14\x04\x37\x26\x97\x92\x92\xb3\xa1\xd4\x73\xd9\x9b\x2e\x9c\xa5\xef\xe2\x0d\x08\x40\x01\x1e\x10\x01\xa5\x03\x47\x2d\x47\x2d\x47\x2d\xc3\x27\xeb\xef\xf7\xdb\x77\x83\x7e\x69\xe8\xe6\x7c\x38\x6c\x6c\x7b\x5a\x32\x34\x71\x1a\x47\x2f\xb8\x5c\xaf\x88\x45\xec\x8e\x06\x3a\x1a\xe8\x5f\x30\x9c\x12\x7f\xaa\xbb\xc4\x7c\x3b\xda\xfe\x5e\x2c\xe2\xe0\xbe\x9a\xb7\x53\x8f\xbd\x50\x2f\xd4\x63\x40\xcd\x54\x4a\x32\x92\x4e\xd4\xc5\xd8\x3a\xf0\x45\xa2\xed\x1b\x88\x80\x0c\x28\x1d\x74\x23\x0f\xd1\xf6\x91\x68\xfb\x06\x14\xe0\x01\xf0\x74\x23\x0f\xd1\xf6\x0d\x40\x28\xd4\x12\x6a\x09\xb5\x84\x5a\x42\x2d\xa1\x96\xd2\x0c\xa5\x19\x4a\x33\x14\x42\x85\x50\x21\x54\x08\x15\x42\x85\xd0\xd3\x77\x4f\xe1\x00\x73\x80\x39\x40\x18\x28\x1c\x1e\x0a\xc3\x1c\xb2\x11\xa3\xf6\xd5\xf2\xf6\xcd\xc9\x7d\x28\x65\x2b\xab\xd4\x15\x31\x34\x29\x71\x34\x02\xb5\x17\xaf\x98\x8e\x19\x51\xfb\x1e\xd0\x80\x00\x14\xe0\x01\x11\x90\x00\x19\x50\x3a\xe8\x62\xa0\x88\x81\x22\x06\x8a\x18\x68\xd7\x10\x34\x00\xa1\x83\x47\xa8\xae\x10\x1a\x51\x0d\xdf\xbc\xae\x2e\x46\xee\xef\x87\xf5\xda\xb8\x71\xb8\x50\x7c\x19\x0d\xcd\x32\x7a\x48\xb7\x63\x47\x31\xef\xe3\x91\xbd\x23\xb2\x77\x44\xf6\x8e\xc8\x96\x11\xd9\x32\x1a\x28\x1d\x18\xb7\xef\xaf\xe3\xcb\xbe\xd9\x97\xc7\xfb\xbd\xd5\x95\xa6\x55\x9a\x46\x35\x5a\x70\xd6\x3e\xa8\xea\x4d\x9b\x79\xe8\xcb\x6e\x03\x02\x30\x34\x52\x9b\xed\x76\x88\x4f\x7e\xb1\x08\xa9\xfc\xe7\x7a\xbb\xdd\x7f\x6a\xaa\x2b\xab\xb5\xf5\x94\xb4\xbc\x67\x84\xba\x6b\xeb\x98\xa9\x68\xca\xab\xee\x0b\x37\xec\x6a\x85\x03\x4b\xe1\xc0\x52\x38\x9e\x14\x67\xec\x6a\x2f\x36\xb7\xb7\xfb\xda\x8a\xb3\xed\xfe\xba\xd0\x42\xa9\x9e\x96\x69\x1c\xea\x49\x23\xa7\x71\x23\xae\x02\xbe\xb2\xdf\xaf\x40\xfc\x64\x20\x7e\x32\x14\x8e\x1c\xa5\x1d\x27\x06\x17\xed\xf5\xb6\x5e\x5a\xce\x36\xf8\xf9\xfa\x53\x5d\x12\xda\xb5\xef\x8b\x1f\xbd\x71\x34\x74\x65\x1a\xda\x1d\x72\x71\xb6\xdb\x70\x71\x66\xc3\x23\x0d\x6f\xfa\xa7\x21\x92\xed\x66\xbe\x3e\x6d\xe5\xcf\xdf\xff\x35\xd3\x7b\x3d\x76\x97\x30\xfa\x4a\x49\x30\xd2\x40\x85\x55\xbd\xa8\x59\x07\xb8\x7a\x11\xed\xf2\xca\xf5\x2b\x72\xfd\x6a\x20\x02\x12\x20\x77\xd0\x17\xa9\xcc\x22\x95\x59\xa4\x32\x5b\x54\x66\x6d\xca\x6c\x51\x99\x45\x2a\x77\x5d\x65\x03\x14\x16\xca\x28\xcc\x0a\xb3\x52\x58\x29\xac\xb4\x50\xa9\xe5\xf9\x29\x50\x38\xf0\x97\x68\x64\x77\xda\xec\x2e\xf7\xdb\xdd\xd9\x71\xaf\xf7\xcb\xcb\xd9\x54\x47\x4a\xc8\x3a\xc4\x65\x4f\xab\xda\x9a\x31\x11\x55\x3d\x51\x4f\x62\x9e\x09\x72\x60\xe4\xfb\x01\xa6\x01\x0f\x08\x80\x08\x48\x80\x3e\x86\x81\x29\x08\x8c\x7c\x60\xc0\x83\xf0\x53\xf7\xa6\xae\x80\x32\x0c\x78\x90\x87\xc2\xd9\xb8\x5b\xdf\x5e\xfc\x63\xfd\x7e\x3e\x5d\xa8\x7e\xfe\x87\x11\x7d\x32\xfa\x54\xd4\xc1\x59\x06\x72\x68\x5c\xb9\x58\xc6\x40\x0e\xf7\xc5\xd1\xd1\x3a\xdf\x65\x96\xa8\xcc\x12\xd5\x80\x07\x44\x40\x02\x64\x40\xe9\xa0\xe7\x53\x28\x5d\xfa\x1a\xa0\x8c\xa3\x8c\x50\x46\x78\x56\xcf\x56\x51\xfa\xb9\xa8\x01\x0a\x2b\x85\x95\xc2\x4a\x7b\x7a\x86\xc0\xd2\x0f\x3f\x0d\x50\xcb\x43\xe8\x29\xec\x29\xec\xe9\x85\xa7\x85\x9e\xea\x9e\xea\x81\x32\x71\x5c\x95\x7f\x59\x2f\xb2\xc1\x2c\x27\xa8\xa9\x6e\xf6\x96\x29\xbc\x8a\xc3\x68\x9c\x0b\x52\xa2\x95\x0d\xa6\xca\xb5\x3d\x43\x81\x19\x0a\xcc\x50\x97\xe4\x8c\xb6\xaa\x81\xde\x93\xee\x34\xd1\x00\x85\x99\x86\xc0\xe8\x07\x51\x43\xd9\xdc\x3c\x77\xc6\x03\xfc\x20\x8c\xa7\xe5\x4e\x45\x32\x48\xf2\x63\x50\x7c\x54\x3b\xfb\x4d\xb4\xb3\x58\x92\x17\xb4\x01\x05\x04\x80\xb1\x69\xd6\x05\xc4\xb8\xbf\xfe\xfc\xec\xbf\x11\x7a\x5b\xc5\x39\xc4\xd1\xf2\x51\x4a\xb0\xd4\xcd\x3e\x98\x97\xc2\x89\xb3\xe9\xc4\xd9\x74\xe2\x6c\x3a\x71\x36\x9d\xd4\xd2\xb1\x3d\xd9\xd7\x75\x72\xa1\x2a\x5f\xb6\xff\xc5\xba\x5e\xf3\x6e\x66\xc3\x1d\xd1\xb7\x40\x96\xc1\x98\x2e\x53\xb6\x6c\x68\x41\x6d\x5d\xb9\x2a\x6d\x57\xe3\x40\xf5\x73\x95\xf0\xfb\xf9\xff\x7d\x7c\x73\xf4\x86\x99\x2f\xc5\x31\xdb\x71\x1b\xde\xf8\x8d\x26\x4e\x47\xef\xf1\x06\x04\xa0\x00\x6f\xb9\xff\xf4\x85\xf3\x8f\x46\xff\xb8\x70\x67\xb9\xb5\x6c\x0f\x92\x92\x1b\xb4\xcc\xd3\x2a\xe9\x98\xaa\x2c\xf9\x95\x4b\x62\x26\x36\x49\x08\x41\x42\x08\x12\x42\x90\xb0\x16\x25\x52\x9d\x24\xcc\x46\x09\xb3\x51\x22\xf9\x49\x6a\x3b\xfd\xe0\x6a\x79\x68\xa9\xbc\x76\x67\x3b\x57\x25\xe6\xd2\xd8\x56\xb3\x2f\x43\x94\xd6\xb4\x6a\xf1\xcd\x46\xe7\x52\xb0\xad\x42\x19\x73\x5d\xc6\x5c\x97\xbb\xf3\x64\x03\x0e\x40\x19\xa1\x8c\x61\x11\xf8\x71\x7d\xb8\x5d\x7f\x36\x56\x9e\x45\x77\x9e\xdd\x5f\x99\xa9\xd1\x7d\x6f\xe3\x62\xb2\xdc\xa8\x9b\xad\xfd\x99\xbc\x3a\x73\xb2\x94\xc9\x52\xe6\x48\x99\x23\x65\x8e\x94\x39\x52\xe3\x6e\xf4\x68\xfb\xf6\xfe\x7f\xdd\xaf\x0f\xf5\xbf\xd3\x6e\xbc\x58\x5c\x8f\xd6\x87\xdd\xbc\xad\x2f\xcb\xe8\x13\x14\x7c\xc8\x43\x3c\x69\x8b\x4f\xf4\x63\xdc\xe2\x14\x5b\x80\x90\xb9\x80\x22\x78\x19\x79\xcb\xc8\x5b\x46\xde\x32\xf2\x96\x91\xb7\x06\x4a\x07\x7d\x4b\x4f\xfd\xd0\x99\x11\xc5\x06\x14\x00\xb3\x50\x58\x28\x2c\x14\x16\x2b\x62\x78\x77\x37\x5f\xfc\xed\xfc\x10\x7d\x5d\x68\xa1\xd1\x2a\x43\xd6\x95\xd0\xfc\xae\xad\x6c\x49\xe5\x98\x75\x6d\x1c\x1e\xf6\xd3\x14\x18\x95\xc0\x60\x84\xc9\x3e\xa4\x3d\x39\xdc\x5f\x2e\x22\x10\x96\x8d\x7e\xba\xdf\xcd\x17\x8f\x76\xb3\x91\xe2\x29\xba\x3c\x26\xb3\x77\xc6\x39\xad\x4e\x6a\x8a\xa6\x57\x65\xce\x24\xbd\xcd\x3d\xc1\x4a\x03\xa9\x03\xc7\x4f\x86\x76\xb7\x39\x41\xfe\x73\xbf\x5c\x07\xff\x39\x66\xe5\xa1\xd0\x42\x03\x91\x43\x4e\xe3\x72\x91\x8c\xa4\x14\xa9\x5d\x2a\xcd\xa4\x14\x8e\xcc\x31\x8e\xcc\x31\x8e\xcc\x31\x8e\xcc\x31\x8e\xcc\x31\x8e\xcc\x31\x8e\xcc\x31\x8e\x84\x31\x8e\x84\x31\x8e\x84\x31\x8e\x84\x31\x8e\xac\xab\x8e\xac\xab\x8e\xcc\x31\x8e\xcc\x31\x8e\xcc\x31\x8e\xcc\x31\x15\x08\x3c\x02\x8f\xc0\x23\xf0\x08\x0d\x13\x08\x05\x42\x81\x50\x20\x54\x5a\xa8\x30\x2b\xcc\x0a\xb3\xc2\xac\x30\x2b\xcc\x0a\xb3\xc2\xac\x30\x7b\x98\x3d\xd5\x23\xd5\x23\x65\x52\xff\x8b\xa3\x61\x92\x27\x80\x61\xa3\x3f\xec\xf7\x1f\xb6\x9f\x77\x67\x85\xe3\x27\x54\x29\x8b\xdb\xd9\xe4\xd3\x34\x19\x21\x80\x5e\x4d\xc9\x30\xb3\xe5\x39\x7c\xcf\x1a\x50\x80\x07\x04\x40\x04\x24\x40\x06\x94\x0e\xba\x64\xe0\x8d\xe6\xf0\x46\x73\x78\xa3\x39\xbc\xd1\x1c\xde\x68\x0e\x6f\x34\x87\x37\x9a\xc3\x1b\xad\x01\x98\x05\x66\x81\x59\x60\x16\x98\x05\x66\x81\x59\x60\x16\x08\x05\x42\x85\x50\x21\x54\x08\x15\x42\x85\x50\x21\x54\x08\x95\xa6\x2a\xcc\x86\xe7\xdb\xe3\xfb\x77\xef\xe6\x45\xf8\xfc\x72\x66\xbf\x3f\x6c\x8c\x6b\x4b\xac\x37\xaf\xd1\xc6\x9e\xf3\x18\x1c\x96\x72\x0b\x96\x36\x73\x5b\x79\xe6\xd5\x77\x4d\x5d\x03\x0a\xf0\x80\x08\x48\x80\x0c\x28\x1d\xf4\x79\xf5\xcc\xab\x67\x5e\x3d\xf3\xea\x99\x57\xcf\xbc\x7a\xe6\xd5\x33\x79\x5e\xe4\xaf\x5a\xbe\x96\xa3\xf4\x62\xbf\x3b\x58\xd7\xbb\xba\xc9\xc4\xf1\xdc\x5d\xaf\x92\xd1\x70\xb6\xab\xe7\x25\x33\x5f\x99\x8f\x0c\x46\xf7\x8d\x6a\x20\x02\x12\x20\x03\xfa\xa8\x44\x46\x25\x32\x2a\x91\x51\x89\x8c\x4a\x64\x54\x22\xa3\xd2\x63\xef\x9c\x8f\x8c\x4a\x34\x42\xd0\xea\x49\x66\xde\x7d\x3e\x3b\x24\x7f\x14\x59\x6c\x75\x79\xa9\x92\xa8\x92\x13\x83\x58\x7b\x45\x0a\xd1\xdc\x2b\x48\xc2\xd3\x80\x02\x3c\x20\x00\x22\x20\x01\x32\xa0\x74\x60\xf9\x8f\x7d\x3e\xcc\x97\xf7\x8b\x0c\x0e\xcb\xde\xbd\xdc\xed\x77\x57\xf3\x7b\xeb\x3b\x0d\x61\x54\x0b\x37\x57\x1f\xe3\xaa\x15\x57\xce\x9b\x17\x5c\xa7\x74\x50\xe9\xa0\xd2\x41\xa5\x83\x4a\x07\x95\x0e\x2a\x1d\x54\xde\x88\x96\xf1\xc7\xc8\x86\x30\x1f\xb6\xfb\x1e\xdd\xf1\x47\x0f\x9f\x2c\xaf\x06\x97\x1f\xb6\xeb\xdd\xdb\xfb\xc3\x7b\x23\xfd\x7b\x7d\x59\xfc\x70\xca\x12\x49\xce\x48\x6b\x96\xed\x03\xb5\xe0\xdd\x24\x78\x37\x09\xde\x4d\x82\x77\x93\xe0\xdd\x24\x78\x37\x09\xde\x4d\x82\x77\x93\xe0\xdd\x24\x78\x37\x09\xde\x4d\x82\x77\x93\xe0\xdd\x24\x78\x37\x09\xde\x4d\x82\x77\x93\xe0\xb9\x24\x78\x2e\x55\x10\xe1\x89\x3c\x2b\xf1\xac\x04\x73\xa2\xb0\x11\xa7\xfc\xcb\xbc\x5d\x6f\xde\x5f\x9f\x1d\xe9\x5f\x67\x23\x71\x8c\xaf\x9b\x7b\x19\x0f\xfa\x29\x8d\x9a\xa1\xba\xc4\xd6\x4b\x93\xe9\x53\x9c\xba\xc6\xbb\x01\x05\x78\x40\x00\x44\x40\x02\x64\x40\xe9\xa0\x0f\x71\xea\x6b\x48\x03\x10\x3a\x08\x1d\x84\x0e\x42\x23\xe2\xe6\xe9\xfd\x61\xf9\x91\xab\xe5\x80\xfc\x51\x64\xa1\x2c\xcb\xaa\xe3\xb7\x21\x8e\x56\x8a\x61\xd3\x29\xa6\xfd\xb7\x4e\x16\x23\x92\x18\x91\xc4\x88\x24\x46\x24\x31\x10\x89\xde\x26\xe3\x2b\x56\x8f\x6e\xaf\x8d\xaf\x28\x2c\x3b\xf3\xf8\x7e\x77\xb9\xbf\x79\x3b\xce\x70\xcb\x66\xab\x43\x6f\x42\x09\xce\x48\xe5\x16\x82\x19\x39\x24\xb9\xdb\x62\x1a\x50\x80\x07\x04\x40\x34\xbe\x02\x71\x58\x24\x44\xf9\xf9\xe9\x52\x8d\x77\x7b\x6b\x39\x8c\x06\x5f\x0c\x0b\x92\x11\xd7\xda\x5c\x58\x4c\x8d\x72\xc0\xd4\x11\x70\x39\x0b\x5f\x6c\x1e\xa3\x8d\xee\xf6\x66\x3e\x2c\xbe\xcd\xb1\x6c\xe6\xe3\xfb\xc3\xc3\x2b\xb6\xf4\x73\x4f\x32\xb6\xd4\x48\xd9\x5a\xcf\xc4\xcd\x6e\x67\xda\xe7\x72\xbf\x0a\x06\x6c\x03\xe1\x8b\x6d\xc0\x36\x7b\xde\x9f\xde\xff\x5e\x3e\x5b\xa6\x04\x9f\x77\x1f\xb6\x86\xf3\x58\xca\x55\x18\xc7\xd0\xd6\x12\x9d\x18\xb2\x50\x8a\x79\x4e\xf0\x8a\xdb\x28\xbb\x87\x67\xf7\xf0\xec\x1e\x9e\xdd\xc3\xb3\x7b\x78\x76\x8f\x06\x4a\x07\xdd\x89\x4e\xf1\x16\x55\xbc\x45\x15\x6f\x51\xc5\x5b\x54\xf1\x16\x55\x7c\x43\x95\x08\x47\xc5\x49\x54\x09\x75\x54\xbc\x45\x15\x6f\x51\xc5\x49\x54\x71\x12\x55\x82\x1f\x95\xac\x13\xda\x8f\xbe\x0d\x8c\x3e\x67\x4f\xb6\xeb\xdf\xd6\x43\xa0\xd1\x72\xe8\x9f\xdc\x7f\x9e\xaf\xf7\xc6\x7e\xad\x5f\xb2\x52\x0d\xb6\x3b\x5f\x8a\x95\x84\x32\xda\x89\xed\x3d\xe1\x45\x9e\xcf\x3b\x78\x3e\xef\xe0\x71\x38\xf1\x38\x9c\x78\x1c\x4e\x3c\x0e\x27\x1e\x87\x13\x8f\xc3\x89\xc7\xcf\xc4\xe3\x67\xe2\xf9\xbc\x83\xc7\xab\xa4\x02\xa1\x56\x1f\x44\x7c\x48\x2a\xb0\x46\x6a\xb3\xbb\xdc\xec\x76\xb5\x9f\x67\x87\xea\xd9\x7c\xb3\x31\xf3\xda\x49\xe1\xe2\x76\xaa\xbc\x9f\xd4\x59\xbe\x9a\x66\xa0\x93\xc7\x2a\xef\xf9\xd2\xa0\xe7\x4b\x83\x9e\x2f\x0d\x7a\xbe\x34\xe8\xf9\xd2\xa0\xe7\x4b\x83\x9e\x2f\x0d\x7a\xbe\x34\xe8\x03\x22\x19\x10\xc9\x80\xdf\x72\x40\x24\x03\x22\x19\x10\xc0\x80\x00\x06\x12\xa1\x04\xfc\x96\x03\x22\x19\x90\xc4\xd0\x6f\x63\x0d\xd0\x78\xa5\x8c\xd2\x30\x2b\xdb\xe7\x7e\xbb\x5e\xe4\x46\x5d\x8e\xf6\xf3\xfb\x4b\xc3\xa2\x2c\xb9\x05\xf1\x8f\x89\x0e\x8e\xe1\x38\x4b\x7f\xde\xa0\x66\xbe\x69\x4f\x56\x4f\x4f\x56\x4f\x4f\x56\x4f\x4f\x56\xcf\x06\x32\xa0\xbf\x77\x91\xd7\x3f\xf2\xfa\x47\x5e\x7f\xd2\x78\x90\xde\xd3\x93\xde\xb3\x02\xde\xfa\x68\xe8\xf4\x1e\x7d\x38\x2c\x34\xd1\xcb\x71\x78\x7d\x7f\x73\xb3\xb9\xb3\x62\x20\xdd\x70\xd3\x74\xab\x16\xa2\x69\xbc\x9e\xc7\xac\x7a\xe3\xdb\xd9\x0d\x17\x0d\x08\x40\x01\x1e\x10\x00\x11\x90\x00\xfd\xad\x52\x5e\x53\xe5\x35\xed\xe9\x3d\x1a\x80\x90\xf7\x55\x8d\x78\xd0\x97\x1f\xb6\x75\x19\xba\x31\x32\x60\xbc\x5c\x7c\xb0\x8e\x92\xa3\x06\x38\x95\x52\xca\x70\x6e\xe8\x16\x91\x65\x66\x89\x60\x7f\x59\x2f\x61\x7e\x4a\x98\x9f\x12\xe6\xa7\x06\x02\x20\x02\x12\x20\x03\x4a\x07\x3d\x7a\x16\xd7\xa8\x06\xe0\x71\x54\x77\xd4\x72\xd4\x3a\x0a\x4b\x03\xd4\x12\x1e\x21\x94\x51\x7e\x52\xda\xa3\xfc\xe4\xf9\x29\xf0\x97\x28\xc6\xf7\xdb\xb6\xb7\xf3\xd9\x41\x7e\x28\xb1\xd8\x12\x5c\x8f\x21\x39\x35\x9b\x8f\x96\xa8\xe6\xcd\x61\x3b\x45\x26\x36\x82\xc4\x46\x90\xd8\x08\x12\x1b\x41\xe2\x83\x3f\x89\xf5\x3f\xe1\x67\x98\x58\xf6\x13\xcb\x7e\x62\xd9\x4f\x2c\xfb\x09\x1f\xc2\xc4\xfa\x9f\x58\xff\x13\x3e\x84\x09\x1f\xc2\x84\xeb\x60\xc2\x75\x30\xe1\x3a\x98\x3c\x03\x8c\x0f\x61\xc2\x87\x30\xe1\x31\x98\xbe\x78\x0c\xfe\xa5\x10\xdf\x97\x8b\xaf\x88\xbd\xb8\xdf\xde\xed\xaa\x20\x5f\x9b\xee\x09\xcb\x74\xb0\xbe\x9e\x80\xdd\x18\xdf\xdc\x3e\xad\x14\x6d\x35\x52\x49\xfd\xd8\x53\xc8\x96\x52\xc8\x96\x52\xc8\x96\x52\xc8\x96\x52\xc8\x96\x52\xc8\x8d\x52\xc8\x8d\x52\xc8\x8d\x52\xc8\x8d\x52\xc8\x8d\x52\xc8\x8d\x52\xc8\x8d\x52\xc8\x8d\x52\xc8\x8d\x52\xc8\x8d\x52\xc8\x8d\x52\xc8\x8d\xd2\x00\xcc\x42\x61\xe5\x27\xe5\x59\xca\xb3\x14\x66\x85\xc7\xf0\x38\xf8\xfe\xfe\xfd\x7a\x91\xfc\x6a\x39\xf2\xcf\x67\xd3\x5f\x30\xba\xe1\x73\x12\x5f\x3e\x84\x36\xba\x52\x89\xd6\x85\xd6\x0c\xac\x2e\xc9\x33\xe8\x9e\x41\xf7\x0c\xba\x67\xd0\x3d\x83\xee\x0d\x9b\xc9\xeb\x7a\x33\xbe\x39\x2f\x39\x76\x1e\xb6\xfa\x1e\xc6\x65\xa6\x90\x16\x26\xe0\xa5\x98\x3d\xd0\x60\x7e\x41\x27\x29\x3d\x50\x7a\xa0\xf4\x40\xe9\x81\xd2\x03\x45\x6c\xac\x5c\xc1\xaf\xae\x37\xdb\xf9\x6a\xbd\xfd\x78\xbd\xf0\x7b\x7c\xb5\x4c\x41\xb5\x28\xb8\xe8\x97\xc4\x69\x58\x77\x5a\x2e\x09\x43\x37\x16\x56\x2e\x9a\x09\x6e\x1c\x4e\x3a\x0d\x28\xc0\x03\x22\x20\x75\xd0\x75\x83\xa5\xaf\x37\x0d\x04\x00\x85\xbb\xba\x1b\x6f\x1d\x87\xb7\x8e\xc3\x5b\xa7\x01\x1e\x2a\x3c\x54\x20\x14\x08\x05\x42\xe1\xe9\x02\xb3\xc0\xac\x30\x2b\xcc\x0a\xb3\xc2\xac\x30\x2b\xcc\x0a\xb3\xc2\xac\x30\x2b\xcc\x1e\x66\x0f\xb3\x87\xd9\xc3\xec\x61\xf6\x30\x7b\x98\x3d\xcc\x1e\x66\x0f\x73\x80\x39\xc0\x1c\x60\x0e\x30\x1b\xdf\x17\x7d\xb5\xb9\xbb\xbb\x6d\xfa\xb5\xeb\xb3\x42\xd3\x72\xd8\xbd\xbf\x5e\x1b\xba\xd4\x2a\x9d\x69\xf2\x83\x79\xc5\x1b\xc9\x58\x52\x69\x9e\x2a\xd6\xe9\xc8\xe1\x30\xeb\x38\x91\x3b\x4e\xe4\x8e\x83\xb8\xe3\x20\xee\x38\x88\x57\xd0\x55\x8c\x1c\xc4\x1d\x9f\xfc\x76\x9c\xc8\x1d\x27\xf2\x06\xa8\xee\x1e\xaa\x97\x0e\x04\x1e\x81\x47\xe0\x11\xaa\x0b\xcd\x10\x78\x94\x32\xca\xb3\x94\xc2\x6a\x7e\xc5\xe6\xb0\xb9\x45\x97\xf9\xcd\xa1\x7e\x3a\xdf\xd7\x57\xd3\x08\x3d\x28\xfd\xe0\x7d\xea\x0f\x91\x54\x0c\x9d\x6e\x3e\xc6\xed\x0c\xe3\x9c\xba\xeb\x8f\xc3\xf8\xee\xf0\xfa\x70\x58\xe1\x1d\x86\x75\xf7\xc5\xb0\x3e\x6a\xdd\xb7\xcd\xa5\xe3\xd3\xee\x6c\x27\x9e\xaf\xaf\x2d\x75\x48\x7d\x8b\xa7\xe5\xf7\x83\x9b\x2d\xee\x18\xa2\xbd\x5c\x5d\x7c\x32\x3f\xa9\xd4\xc2\xbf\x8f\x9d\x40\x85\xe3\x50\xe1\x38\xdc\x56\x9d\x19\x23\x88\x1d\xe9\x9b\xcd\x36\x0d\x4d\xf5\x69\x29\x8f\xe6\x82\xba\x5c\x88\xa1\x68\xee\x1f\x87\x19\x1a\x1d\xbb\x0e\xa7\x01\x01\x28\xc0\x03\x02\x20\x02\x12\x20\x03\x4a\x07\x7d\x9a\xbe\x7c\xc8\x67\x78\xaf\x0f\xfb\xdf\x36\x57\x43\x22\xaf\x5f\x16\x11\x91\xa7\xc5\x16\x01\x48\x79\xf2\x63\x9e\xbb\x2c\xa6\x2f\x94\x33\x4f\xa0\x93\xf0\x75\x1e\xe9\x61\x52\x0d\x04\x40\x04\x24\x40\x06\x14\xcb\x41\x60\x88\x31\x5a\x76\xe7\xab\x32\x0b\xe7\x9b\x98\xc4\xd0\xaf\x98\xe9\x1b\xf4\x5b\x7d\xc9\xfe\x2f\x86\x1a\xbc\x5e\xa8\x5f\x7f\xd9\x5c\x5e\x9b\xf9\x65\x9a\x0c\x2d\x1b\xd6\x3e\x39\xd7\x73\xd6\x2e\x73\xcb\xab\xe9\xcf\x27\x04\x79\x09\xb1\x5d\x42\x6c\x57\x03\x01\x10\x01\xa5\x83\xae\x4e\x2f\xe4\x33\x29\x62\x45\xf7\x37\x43\xcd\x7a\xc8\x9c\xb1\xec\xe2\x69\xb1\x45\x27\xa7\xae\xef\x3c\xfd\xa2\x66\x1a\x3d\xd3\xdb\x0e\xa1\xe6\x07\x35\xa5\x90\x99\xa4\x90\x99\xa4\xf4\x90\x53\x29\x3d\x9c\xb4\x81\xde\x6d\x5f\xc4\x08\xe9\x5e\xaf\x8d\x0f\x77\x2c\x7b\x72\x5a\x6c\x71\x59\xf0\xc6\x07\x02\xb3\x71\x36\xca\xb2\xb2\x33\xb6\x0a\xfe\xff\x0d\x04\x40\x02\xf4\xa9\xe9\x4a\x8f\x06\x8c\x00\xd7\xcd\xfe\xfe\x77\x23\x03\xfa\xeb\x65\xfa\x95\x16\x4e\x7c\xdd\xe3\x09\x96\xd9\x25\xf2\x10\x82\xa1\xab\xe0\xbd\xe9\xb1\x6e\xcf\x48\x60\xe7\x08\xec\x1c\x01\xff\xad\x80\xff\x56\xc0\x7f\x2b\xe0\xbf\x15\xbe\xec\x2e\xa3\x85\xea\xe3\xe6\x6a\xd4\x47\x2c\x7b\xf4\x6a\xbd\xdb\x7d\xe3\x63\xd0\x31\x95\xc1\xa4\xeb\xdb\x47\x1a\x8c\x2f\xd4\xe9\x4a\xd4\xee\x51\xb7\xcb\x34\x20\x00\xdb\xcb\x74\x94\xa3\x37\x3f\x2f\xf7\xf1\xba\xb2\x9a\xf1\x8e\x2d\x43\x9a\x18\x1e\xf1\x46\x96\xc1\x66\xbe\x70\xe6\xe7\x18\xb8\x5f\x2a\xf7\x4b\xe5\x7e\xa9\xdc\x2f\x95\x6c\x9c\x4a\x36\x4e\xe5\xc6\xa9\xdc\x38\x95\x1b\xa7\x72\xe3\x54\x6e\x9c\xca\x8d\x53\xb9\x71\x2a\x37\x4e\xe5\xc6\xa9\xdc\x38\x95\x1b\xa7\x72\xe3\x54\x6e\x9c\x0d\x8c\x57\xaf\x17\xeb\x9b\x7a\xcc\xb9\x3d\x3b\x84\xaf\xaf\xd7\xdb\xb7\x46\xf2\x20\x1f\x73\x1e\xb5\x4f\xf5\x2c\x1c\xac\x7c\x77\xde\x3a\x71\x2a\x47\x06\xe5\xc8\xa0\x44\xba\x28\x91\x2e\x4a\xa4\x8b\x12\xe9\xa2\xb9\xeb\x9c\x94\x48\x17\x25\xd2\x45\x89\x74\x69\x00\x1e\x07\x8f\x83\xc7\xc1\xe3\xe0\xe9\xa3\x45\x10\x4c\x03\x54\x17\xaa\x0b\xd5\x85\xea\xca\xd3\x95\x5a\x4a\x77\x94\x66\x28\x3c\x7e\x3c\x1f\xfc\xb4\xdb\xff\xfe\xe7\x32\xdc\x4a\x59\x07\x83\x9c\x46\xc3\x7b\x5d\xbd\xa2\xa1\x17\xae\xfb\x8d\xf9\x05\x9d\xd4\x03\xa8\x1b\xe8\x82\x53\x10\xc0\x82\xdc\x15\xe4\xae\x20\x77\x05\xb9\x2b\xc8\x5d\x41\xee\x0a\x72\x57\x90\xbb\x22\x10\xf6\xef\x13\x55\x00\xb3\x52\x46\x29\x63\xf8\xfc\xb6\x6f\xc2\xdc\xcd\xbb\x7d\x37\xda\x7c\x73\x9c\xbe\x69\xae\x70\xb1\x87\x0f\x2c\x5c\x65\xfd\xe8\xb0\x9f\xc3\x4a\xed\xdc\x36\x8a\x7e\x43\xd1\x6f\x28\xfa\x0d\x45\xbf\xd1\x40\x04\x24\x40\x06\xf4\x21\xf2\xbc\xf4\x9e\x97\xde\x33\xe6\x7c\x7b\xa6\x02\x78\x18\x58\x6f\x38\xdf\x3c\xdb\xdf\x0f\x67\x82\x37\xff\xc3\x8a\xf8\x36\xd2\x5d\xb7\x0f\xd2\xb9\x21\x5f\x65\xf3\x0a\x30\x94\x9b\x1a\x4d\xfb\x6d\x5d\x99\xbb\xf6\x38\x75\x17\xcd\x06\x3c\x20\x00\x22\x20\x01\x32\xa0\x74\xd0\xf5\x9e\xa9\xfb\x6a\x36\x00\xb3\x83\xd9\xc1\xe3\xe0\x71\xf0\x38\x78\x04\x1e\x81\x47\xe0\x11\x78\x84\x16\x0a\x2d\x14\x98\x05\x66\x81\x59\x60\x56\x98\x15\x66\x85\x50\x21\x54\x08\x15\x42\x85\x50\x21\x54\x08\x3d\x84\x1e\x42\x4f\x53\x3d\xcc\x1e\x42\x0f\xa1\x87\xd0\x43\x18\xa8\x1e\xa8\x15\x68\x4f\xa0\x7a\xa0\x7a\xa0\x7a\xa4\x56\xa4\x56\xa2\x56\xa2\x56\xa2\x56\xa2\x56\xe2\xa1\x89\x5e\x64\x7a\x91\x21\xcc\xf4\x22\xc3\x9c\x61\xce\x30\x67\x08\x33\x84\x19\xc2\x02\x4f\x81\xa7\xc0\x53\xe0\x29\xf0\x14\x5a\x58\xe0\x31\x32\xdd\x3e\x6d\xdf\x2a\xbb\x3d\xfb\x9a\xfc\x51\x64\x71\x57\x2e\x7d\xef\x1f\xce\xcd\xc9\x3a\xa5\x99\x4b\x47\x42\xb1\x92\xb0\x79\x26\x34\x2c\x09\x0d\x4b\xc2\xe6\x99\x50\xb5\x24\x54\x2d\x09\x55\x4b\xc2\xe6\x99\xd0\xb9\x24\x74\x2e\x09\x9d\x4b\x42\xd5\xd2\x00\x84\x0e\x42\x07\xa1\x83\xd0\x41\x28\x10\x0a\x0d\x13\x78\x04\x1e\x81\x47\xe0\x11\x78\x04\x1e\x85\x47\x69\x98\xd2\x30\x85\x59\x21\x54\x08\x15\x42\x85\xd0\xc3\xe3\xe1\xf1\x54\xf7\x34\xcc\x53\xdd\x53\xdd\x3f\x54\xa7\x3d\x81\xea\x81\x66\x04\x78\x0c\x4d\xdc\xeb\x79\x77\xf1\xa8\x05\xcb\x6c\xf6\x67\xc5\xe5\xf1\xfa\xf7\xd9\xc8\x89\xa7\xd2\x16\xfe\x61\x51\xf5\xe2\xc7\xfc\x26\xb9\x5e\x7d\xd5\x3a\x55\x26\xb2\x7e\x25\xfc\xe2\x12\x7e\x71\x09\xbf\xb8\x84\x5f\x5c\xc2\x1d\x2e\xe1\x0e\x97\x70\x87\x4b\xb8\xc3\x25\xdc\xe1\x12\xee\x70\x09\x77\xb8\x84\x3b\x5c\xc2\x1d\x2e\xe1\x0e\x97\x70\x87\x6b\x00\x66\x07\xb3\xc0\x2c\x30\x0b\xcc\x02\xb3\xc0\x2c\x30\x0b\xcc\x02\xb3\xc0\x2c\x30\x2b\xcc\x0a\xb3\xc2\xac\x30\x2b\x84\x0a\x8f\xc2\xa3\xf0\x78\x78\x3c\xd5\x3d\xed\xf1\x54\xf7\x54\xf7\x54\xf7\x54\x0f\x54\x0f\x34\x23\xc0\x13\x68\x46\x80\x30\x40\x18\xe8\x60\x80\x39\xc0\x1c\x60\x8e\x30\x47\x98\x23\x84\x11\x42\xc3\xce\xf3\xa8\xee\xf5\x9b\xf3\x7b\xfd\x9b\x43\xbd\xee\x8c\x8b\x58\x3d\xdc\xe9\x32\x68\x5e\xa7\x95\x44\xf3\x13\x04\xc9\x76\xd2\x6c\x9e\x49\xbd\xbd\x09\xa9\x4c\x48\x65\x42\x2a\x13\x52\x99\x10\xb4\x84\x10\x25\x24\x25\x21\x29\x09\x49\x49\x48\x4a\x42\x52\x12\x92\x92\xe4\xa1\x7a\x06\xf4\x81\x4c\x48\x4a\x42\x52\x12\x92\x92\x90\x94\x84\xa4\x24\x85\x10\x01\x49\x9e\x5a\xc8\x45\x42\x2e\x92\xa7\x30\x02\x92\x10\x90\x84\x80\x24\x04\x24\x21\x20\x09\x01\x49\x08\x48\x42\x40\x12\x72\x91\x90\x8b\x84\x5c\xa4\x60\xa8\x48\xb7\x17\xaf\xe6\xdb\xf3\x2b\xd0\x57\x65\x16\xf7\xb2\xe2\x86\x40\xe2\xf6\xa1\x75\x67\x86\x10\x79\x33\x7d\x7e\xe2\x6b\xd3\x0d\x08\x40\x01\x1e\x10\x00\xa9\x03\x47\x61\xc7\x4f\xc2\x5f\x84\x5a\xc2\x4f\x7d\xfe\x4a\x3f\xfd\x37\x40\x19\x7d\x28\x13\x01\xdf\xfa\xf4\x4f\xfd\xbf\xeb\xf3\x6f\x45\x3d\x01\xcf\x46\x7e\xc0\x2f\xf6\x73\x63\x6b\x3f\xa6\x96\x33\xbe\xcc\x61\x9e\x80\x23\xfe\x13\x7c\x9b\xbe\x01\x0f\x08\x80\x08\x48\x80\x0c\x28\x1d\xf4\xc5\x3a\xe2\x48\xc1\x17\xed\x13\x5f\xb4\x6f\x00\x42\x07\xa1\x83\xc7\xc1\x23\xf0\x08\xed\x51\x08\x15\x42\x7d\xf8\x09\x66\x85\x59\x69\xa1\x87\xc7\xf3\x88\x48\xe1\x44\x99\x54\x2c\x73\xee\xdd\xc5\xf3\xf9\xc3\x7a\x54\x25\xfd\xc7\x9b\x65\xec\x5e\x2f\x6a\xe4\x6e\x8c\xde\x8a\xaa\x8d\x93\x11\x1e\xee\x56\xd9\x4c\xf3\x91\x49\xfa\x9d\xf1\xca\xcb\x38\x63\x64\x9c\x31\x32\xce\x18\x19\x67\x8c\x8c\x57\x5e\xc6\x2b\x2f\xe3\x8c\x97\xf1\xca\xc8\x78\xe5\x65\xbc\x32\xf2\x97\xec\x4f\x96\x01\x60\x7f\x76\x08\xfe\xe3\x6e\xb6\x2c\x34\x75\x43\x32\xcc\x4c\xaa\x66\xef\xa3\xfd\x0d\x8c\xec\x23\xdd\x8f\x74\x36\x1a\x0e\xc0\x2f\xdf\xf7\xe4\xb7\xdf\x6c\xe4\xaf\xeb\xb7\x56\x6c\xb0\x64\x19\xfd\x25\x65\xb4\x22\xb5\x36\x96\x64\x27\x2c\xf0\x7c\xc0\xd1\x7b\x66\x86\x0f\x38\x7a\xeb\xab\x41\xcd\xc9\xd7\xf8\x6a\xc5\x3f\xde\x2c\x35\xe1\x9b\xbb\xbb\xf5\xce\xca\xea\xdb\x0e\x63\xa3\xd3\x40\x0b\x0c\x36\xe2\x4e\xe4\xb8\x96\x0e\x66\x08\xf2\x33\x4e\xc1\xf2\x68\x68\x39\xb7\x3f\xae\xb7\x9b\x45\xac\xd0\xb2\x91\x2d\x15\xc4\x37\x34\xa9\x29\x87\xd1\x35\x43\xac\x4c\xd7\xb2\x0a\x29\x98\x31\xe5\xc1\xca\x6d\xd3\xac\x20\x37\xfb\x85\xa7\xce\x3f\x1e\x8d\xa6\x92\x56\xc8\xce\xdc\x50\x8f\xa0\x32\x24\xc5\x4b\xab\xe0\x47\x7b\x49\x4a\x2b\x6f\x9e\x71\x05\x27\x61\xc1\x49\x58\x70\x12\x16\x9c\x84\x05\x27\x61\xc1\x49\xb8\x81\x00\x88\x80\xd4\x81\xc2\xa3\x14\x36\xec\xbf\x3f\xef\x0f\x24\x17\xff\x66\xf7\x7b\x99\x6f\xf4\xde\xcb\xf8\xdd\x93\xb8\x6a\x39\x60\x0c\x13\xb0\x64\xd3\xd4\x5e\x5b\xd6\x23\x5e\xb4\xdb\x1f\x1b\xf0\x80\x00\x48\x80\x0c\xe8\x83\xd6\xcd\x8e\x0d\x38\x00\x3c\x8e\x5a\x8e\x5a\x96\x1f\xa6\xfd\x1a\x2d\x3d\x0e\xbe\x2e\xb5\x10\x82\xd4\x55\xe5\x27\x29\xba\x72\x76\x56\x40\xdb\x64\xae\xcf\xed\x63\xe6\xc7\xd6\x13\xc6\x25\x84\x71\x09\x61\x5c\x42\x18\x97\x10\xc6\x25\x84\x71\x09\xd1\x5b\xed\x5b\xe8\x6a\xc4\xeb\xcd\xbb\xfd\x87\xf5\x79\x41\x3f\x96\xb1\x67\xba\xa5\x14\x91\x31\x9f\xda\x18\xd9\xd4\x0c\x66\xde\xb4\x92\xb7\x8f\xa3\x1d\x7b\xe8\xbb\x3a\xab\x01\x05\x78\x40\x00\x44\xc0\xe8\x5b\xf4\xe2\xf2\xf9\x7a\x3e\x3f\x5d\x7f\x9b\x37\x87\x77\xf3\xa8\x2c\xf6\x4d\x1b\x3e\xa6\x53\x3b\x7e\x53\x77\xc8\xf4\x9f\x92\x9a\x93\xe5\x98\x2c\x67\xac\x2b\xaf\xd7\xf3\xdd\xdd\x42\x91\xfd\xeb\xa3\x31\x36\xd8\x30\xc4\xe4\x38\x24\x59\x6f\x21\x90\xd1\xf4\xfd\x53\x35\x17\xe4\x82\x3b\x42\xc1\x1d\xa1\x60\x5b\x28\xd8\x16\x0a\xb6\x85\x82\x6d\xa1\x60\x5b\x28\xd8\x16\x0a\xb6\x85\x82\x49\xa1\x60\x49\x28\x58\x12\x0a\x96\x84\x82\x25\xa1\x60\x49\xa8\x40\x68\x86\x50\x5d\xa8\x2e\x54\x57\x1a\xa6\x34\x4c\xf9\xc9\xf3\x97\xc4\x23\x0c\x7d\xd6\xeb\x8f\xfb\x0f\xf3\xee\xfc\x60\x7f\x55\x66\xf1\xe2\xb6\xc4\x45\xe3\x78\x87\x6c\x9c\x2d\xea\xf2\x6d\x26\xca\x2a\x58\xbb\x0b\x29\x4d\x0b\x66\xef\x82\xd9\xbb\x60\xf6\x6e\x20\x01\x72\x07\x8e\x5a\x8e\x9f\x1c\x3f\x09\x3c\x46\xc4\xe2\x9b\xf9\xb2\xbb\x42\x7f\xb3\xe3\xaf\x36\x2d\x95\x89\xe1\x3f\x9e\x75\x48\xfd\x91\xda\x67\xc5\x8a\x25\x67\xde\x9b\xa9\xb1\x4b\xc6\xcb\x31\xe3\xe5\x98\xf1\x72\xcc\x78\x39\x66\x8f\x78\x79\xc4\xcb\x23\x5e\x1e\xf1\xf2\x88\x8e\x47\x62\x3c\x12\xe3\x3d\xcc\x48\x83\xf7\x30\xc7\x71\x4b\x7b\xb9\xfd\x7c\xf3\x71\x73\x7e\x50\xde\x5c\xdf\x1f\x4c\xbf\x00\x1f\xfd\x90\xac\xba\xae\xd7\x9a\xcc\x51\x29\x76\xee\xc2\x42\x6c\x54\x21\x81\x5a\x21\x4b\x5a\x21\x15\x5a\x03\x0a\x88\x7f\xd5\xc3\xe1\xd7\x45\x46\xa9\x9f\xe6\xdd\xfc\xc9\x30\xa6\x87\x7a\x5b\x19\x13\x46\x6a\x4f\x88\xb9\x8c\xdd\x51\x53\xa8\x25\x74\x47\xd0\x06\x04\xe0\x3b\x70\xfc\xe4\xf8\xc9\xf1\x53\x36\x16\xeb\xfd\xe1\xfd\x3c\x7a\x68\x2d\xbb\x53\x0f\x8a\xb5\x6d\xf3\xd6\x70\x02\x95\x32\x2c\x8b\x5a\xda\x77\xc5\x4d\x7f\x8d\x60\x1f\x33\xf0\x77\x12\x9c\x9a\x24\x5a\x59\x4b\x5e\x6c\xb6\x9f\xe6\xfb\x0f\xeb\xc5\x8a\xf2\xc3\xd2\x95\xe1\xab\x52\x8b\x4f\xf6\xf9\xac\xe3\xc7\xdc\x5b\xe0\xaa\xf1\x6d\xf4\x32\xd9\x39\xcf\x09\x56\x0b\x04\xab\x05\x82\xd5\x02\xc1\x6a\x81\x60\xb5\x40\xb0\x5a\x20\xd4\x39\x10\xb5\x16\x88\x5a\x0b\x44\xad\x05\xa2\xd6\x02\x51\x6b\x81\xa8\xb5\x40\xd4\x5a\x03\x30\x3b\x08\x1d\x84\x02\xa1\x40\x28\x10\x0a\x84\x02\xa1\x40\x28\x10\x0a\x4d\x15\x98\xad\xc4\xf3\xf3\xd5\x66\x99\xa5\x7a\x39\x05\x4f\xcd\x15\xbd\x7d\xfd\xa2\x8c\xa3\x9f\x8c\x68\xac\xb2\x6a\x07\x11\x73\xf4\x13\x83\x9e\x18\xf4\xc4\xa0\x27\x06\x3d\x31\xa0\x89\x71\x4c\x8c\x63\x62\x1c\x93\x7b\x28\x9c\x00\x19\xd0\x07\xb4\xa9\xf7\x4c\xcf\xa0\x8b\xc7\xf3\xe7\xb3\x23\xf0\xf8\xd0\xdf\xa9\x65\x0a\xd6\x29\x8c\x97\xba\xba\xe2\x8c\xb1\x7b\x79\x35\x1d\xff\x3c\x0c\x01\xd1\x41\x81\xe8\xa0\x40\x74\x50\x20\x3a\x28\x10\xf0\x13\xbe\xc4\xf9\x8c\xab\xd8\xfa\x73\xfb\x2e\xc0\x69\x17\xfe\xb9\xf4\xc6\x3f\xcc\x37\x1b\xf3\x2d\x8a\xe3\xe7\x78\x9d\x91\xce\xc9\xaf\x9a\x65\xc0\xba\x4e\x0b\x99\x7f\x84\x84\x3f\x32\x59\x39\xe4\xe6\xdb\x8f\x8b\xcb\xe9\xb2\x95\x3f\xcf\x77\x87\xbd\x91\xa1\x28\xd4\x85\x6f\xf4\xc3\xac\x67\xb8\x68\xa9\x17\xeb\x20\x99\x9a\x19\x41\x35\x21\xa8\x26\x24\x7e\x69\xe7\xbf\xfc\xd7\xbf\xfc\x5f\x6c\xb9\x3f\xee\x0f\xa9\x00\x00")
+
+func dataPlacesJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataPlacesJson,
+		"data/places.json",
+	)
+}
+
+func dataPlacesJson() (*asset, error) {
+	bytes, err := dataPlacesJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/places.json", size: 43279, mode: os.FileMode(420), modTime: time.Unix(1476748800, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if (err != nil) {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"data/conditions.json": dataConditionsJson,
+	"data/medications.json": dataMedicationsJson,
+	"data/places.json": dataPlacesJson,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"data": &bintree{nil, map[string]*bintree{
+		"conditions.json": &bintree{dataConditionsJson, map[string]*bintree{
+		}},
+		"medications.json": &bintree{dataMedicationsJson, map[string]*bintree{
+		}},
+		"places.json": &bintree{dataPlacesJson, map[string]*bintree{
+		}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+        data, err := Asset(name)
+        if err != nil {
+                return err
+        }
+        info, err := AssetInfo(name)
+        if err != nil {
+                return err
+        }
+        err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+        if err != nil {
+                return err
+        }
+        err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+        if err != nil {
+                return err
+        }
+        err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+        if err != nil {
+                return err
+        }
+        return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+        children, err := AssetDir(name)
+        // File
+        if err != nil {
+                return RestoreAsset(dir, name)
+        }
+        // Dir
+        for _, child := range children {
+                err = RestoreAssets(dir, filepath.Join(name, child))
+                if err != nil {
+                        return err
+                }
+        }
+        return nil
+}
+
+func _filePath(dir, name string) string {
+        cannonicalName := strings.Replace(name, "\\", "/", -1)
+        return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
@@ -0,0 +1,208 @@
+package ptgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// A Catalog contains the condition and medication metadata that patients are
+// generated from, and the names of the code systems generated conditions are
+// coded with (see CodeSystemNames)
+type Catalog struct {
+	Conditions  []ConditionMetadata
+	Medications []MedicationMetadata
+	CodeSystems []string
+}
+
+// DefaultCatalog returns the catalog compiled into ptgen, coding conditions
+// with every code system
+func DefaultCatalog() *Catalog {
+	return &Catalog{Conditions: LoadConditions(), Medications: LoadMedications(), CodeSystems: CodeSystemNames}
+}
+
+// LoadCatalog reads the conditions and medications from the given paths and
+// validates them.  Each path may be a JSON file or a directory containing a
+// conditions.json or medications.json file.  An empty path uses the catalog
+// compiled into ptgen.  Conditions are coded with the named code systems, or
+// with every code system if none are named.
+func LoadCatalog(conditionsPath, medicationsPath string, systems []string) (*Catalog, error) {
+	c := DefaultCatalog()
+	if len(systems) > 0 {
+		c.CodeSystems = systems
+	}
+	if conditionsPath != "" {
+		data, err := readCatalogFile(conditionsPath, "conditions.json")
+		if err != nil {
+			return nil, err
+		}
+		if c.Conditions, err = decodeConditions(data); err != nil {
+			return nil, fmt.Errorf("Couldn't parse conditions %s: %s", conditionsPath, err)
+		}
+	}
+	if medicationsPath != "" {
+		data, err := readCatalogFile(medicationsPath, "medications.json")
+		if err != nil {
+			return nil, err
+		}
+		if c.Medications, err = decodeMedications(data); err != nil {
+			return nil, fmt.Errorf("Couldn't parse medications %s: %s", medicationsPath, err)
+		}
+	}
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("Invalid catalog: %s", err)
+	}
+	return c, nil
+}
+
+func readCatalogFile(path, defaultName string) ([]byte, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		path = filepath.Join(path, defaultName)
+	}
+	return ioutil.ReadFile(path)
+}
+
+// requiredConditions are the conditions the generator adds by name, so every
+// catalog must contain them
+var requiredConditions = []string{
+	"Hypertension",
+	"Congestive Heart Failure",
+	"Pulmonary Heart Disease",
+	"Diabetes",
+	"Hyperlipidemia",
+	"Emphysema",
+	"Lung Cancer",
+	"Atrial Fibrillation",
+}
+
+var knownMortalityTimes = map[string]bool{
+	"N/A": true, "day": true, "threeWeeks": true, "twoYears": true, "twoyears": true,
+	"threeYears": true, "fourYears": true, "sevenYears": true,
+}
+
+var knownRecoveryEstimates = map[string]bool{
+	"N/A": true, "week": true, "threeMonths": true, "sixMonths": true, "threeYears": true,
+}
+
+var knownCheckUps = map[string]bool{
+	"none": true, "weekLater": true, "ifProcedure-weekLater": true,
+	"ifProcedure-CastRemoval": true, "chemotherapy": true,
+}
+
+// Validate checks that condition and medication IDs and condition names are
+// unique, that every condition's medication exists, that the conditions the
+// generator adds by name exist, that every condition has a code in at least
+// one of the code systems, and that every condition's chances, schedules and
+// ranges are ones the generator understands.
+func (c *Catalog) Validate() error {
+	if len(c.CodeSystems) == 0 {
+		return fmt.Errorf("no code systems to code conditions with")
+	}
+	for _, system := range c.CodeSystems {
+		if _, ok := codeSystems[system]; !ok {
+			return fmt.Errorf("unknown code system %q", system)
+		}
+	}
+
+	medications := make(map[int]bool)
+	for _, m := range c.Medications {
+		switch {
+		case m.ID <= 0:
+			return fmt.Errorf("medication %q must have a positive medication_id", m.TradeName)
+		case medications[m.ID]:
+			return fmt.Errorf("duplicate medication_id %d", m.ID)
+		case m.RxNormCode == "":
+			return fmt.Errorf("medication %d must have an rxNormCode", m.ID)
+		}
+		medications[m.ID] = true
+	}
+
+	ids := make(map[int]bool)
+	names := make(map[string]bool)
+	for _, cmd := range c.Conditions {
+		switch {
+		case cmd.ID <= 0:
+			return fmt.Errorf("condition %q must have a positive condition_id", cmd.Display)
+		case ids[cmd.ID]:
+			return fmt.Errorf("duplicate condition_id %d", cmd.ID)
+		case cmd.Display == "":
+			return fmt.Errorf("condition %d must have a display", cmd.ID)
+		case names[cmd.Display]:
+			return fmt.Errorf("duplicate condition display %q", cmd.Display)
+		case cmd.MedicationID != 0 && !medications[cmd.MedicationID]:
+			return fmt.Errorf("condition %d (%s) has medication_id %d, which is not in the medications", cmd.ID, cmd.Display, cmd.MedicationID)
+		case !knownMortalityTimes[cmd.MortalityTime]:
+			return fmt.Errorf("condition %d (%s) has unknown mortalityTime %q", cmd.ID, cmd.Display, cmd.MortalityTime)
+		case !knownRecoveryEstimates[cmd.RecoveryEstimate]:
+			return fmt.Errorf("condition %d (%s) has unknown recoveryEstimate %q", cmd.ID, cmd.Display, cmd.RecoveryEstimate)
+		case !knownCheckUps[cmd.CheckUp]:
+			return fmt.Errorf("condition %d (%s) has unknown checkUp %q", cmd.ID, cmd.Display, cmd.CheckUp)
+		case len(cmd.CodeableConcept(c.CodeSystems).Coding) == 0:
+			return fmt.Errorf("condition %d (%s) has no code in any of the code systems %v", cmd.ID, cmd.Display, c.CodeSystems)
+		}
+		if _, _, err := parseOvernights(cmd.Overnights); err != nil {
+			return fmt.Errorf("condition %d (%s) has invalid overnights %q", cmd.ID, cmd.Display, cmd.Overnights)
+		}
+		for name, percent := range map[string]int{
+			"abatementChance":  cmd.AbatementChance,
+			"mortalityChance":  cmd.MortalityChance,
+			"procedureChance":  cmd.ProcedureChance,
+			"procedureSuccess": cmd.ProcedureSuccess,
+		} {
+			if percent < 0 || percent > 100 {
+				return fmt.Errorf("condition %d (%s) has %s %d, which is not between 0 and 100", cmd.ID, cmd.Display, name, percent)
+			}
+		}
+		ids[cmd.ID] = true
+		names[cmd.Display] = true
+	}
+
+	for _, name := range requiredConditions {
+		if !names[name] {
+			return fmt.Errorf("missing required condition %q", name)
+		}
+	}
+	return nil
+}
+
+func decodeConditions(data []byte) ([]ConditionMetadata, error) {
+	if err := checkRequiredFields(data, "condition_id", "display"); err != nil {
+		return nil, err
+	}
+	md := []ConditionMetadata{}
+	err := json.NewDecoder(bytes.NewReader(data)).Decode(&md)
+	return md, err
+}
+
+func decodeMedications(data []byte) ([]MedicationMetadata, error) {
+	if err := checkRequiredFields(data, "medication_id", "rxNormCode", "tradeName"); err != nil {
+		return nil, err
+	}
+	mmd := []MedicationMetadata{}
+	err := json.NewDecoder(bytes.NewReader(data)).Decode(&mmd)
+	return mmd, err
+}
+
+// checkRequiredFields checks that the data is a JSON array of objects that
+// each contain the given fields
+func checkRequiredFields(data []byte, fields ...string) error {
+	var entries []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	for i, entry := range entries {
+		for _, field := range fields {
+			if _, ok := entry[field]; !ok {
+				return fmt.Errorf("entry %d is missing %s", i, field)
+			}
+		}
+	}
+	return nil
+}
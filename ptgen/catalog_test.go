@@ -0,0 +1,64 @@
+package ptgen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDefaultCatalogIsValid(t *testing.T) {
+	for _, system := range CodeSystemNames {
+		c := DefaultCatalog()
+		c.CodeSystems = []string{system}
+		if err := c.Validate(); err != nil {
+			t.Errorf("Default catalog coded with %s is invalid: %s", system, err)
+		}
+	}
+}
+
+func TestCatalogValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *Catalog)
+		err    string
+	}{
+		{"unknown code system", func(c *Catalog) { c.CodeSystems = []string{"loinc"} }, `unknown code system "loinc"`},
+		{"duplicate condition ID", func(c *Catalog) { c.Conditions[1].ID = c.Conditions[0].ID }, "duplicate condition_id"},
+		{"duplicate condition name", func(c *Catalog) { c.Conditions[1].Display = c.Conditions[0].Display }, "duplicate condition display"},
+		{"missing medication", func(c *Catalog) { c.Conditions[0].MedicationID = 9999 }, "which is not in the medications"},
+		{"duplicate medication ID", func(c *Catalog) { c.Medications[1].ID = c.Medications[0].ID }, "duplicate medication_id"},
+		{"unknown check-up", func(c *Catalog) { c.Conditions[0].CheckUp = "monthly" }, `unknown checkUp "monthly"`},
+		{"chance out of range", func(c *Catalog) { c.Conditions[0].AbatementChance = 101 }, "not between 0 and 100"},
+		{"missing required condition", func(c *Catalog) {
+			for i := range c.Conditions {
+				if c.Conditions[i].Display == "Hypertension" {
+					c.Conditions[i].Display = "High Blood Pressure"
+				}
+			}
+		}, `missing required condition "Hypertension"`},
+	}
+	for _, test := range tests {
+		c := DefaultCatalog()
+		test.modify(c)
+		err := c.Validate()
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: expected an error containing %q, got %v", test.name, test.err, err)
+		}
+	}
+}
+
+func TestLoadCatalogChecksRequiredFields(t *testing.T) {
+	dir, err := ioutil.TempDir("", "catalog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "conditions.json"), []byte(`[{"display": "Hypertension"}]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadCatalog(dir, "", nil); err == nil || !strings.Contains(err.Error(), "condition_id") {
+		t.Errorf("Expected an error for the missing condition_id, got %v", err)
+	}
+}
@@ -0,0 +1,239 @@
+package ptgen
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+
+	"golang.org/x/tools/container/intsets"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+type ConditionMetadata struct {
+	ID                   int    `json:"condition_id"`
+	ICD9                 string `json:"icd9code"`
+	ICD10                string `json:"icd10code"`
+	SNOMED               string `json:"snomedCode"`
+	Display              string `json:"display"`
+	MedicationID         int    `json:"medication_id"`
+	Overnights           string `json:"overnights"`
+	AbatementChance      int    `json:"abatementChance"`
+	Fatal                bool   `json:"healOrDeath"`
+	MortalityChance      int    `json:"mortalityChance"`
+	MortalityTime        string `json:"mortalityTime"`
+	RecoveryEstimate     string `json:"recoveryEstimate"`
+	ProcedureChance      int    `json:"procedureChance"`
+	ProcedureSuccess     int    `json:"procedureSuccess"`
+	CheckUp              string `json:"checkUp"`
+	Chronic              bool   `json:"chronic"`
+	ProcedureDescription string `json:"procedureDescription"`
+	ProcedureCode        string `json:"procedureCode"`
+	ProcedureCodeSystem  string `json:"procedureCodeSystem"`
+	ProcedureName        string `json:"procedureCodeName"`
+}
+
+// LoadConditions returns the condition metadata compiled into ptgen
+func LoadConditions() []ConditionMetadata {
+	j, err := Asset("data/conditions.json")
+	if err != nil {
+		panic("Can't get the condition data")
+	}
+	md, err := decodeConditions(j)
+	if err != nil {
+		panic("Can't parse the condition data: " + err.Error())
+	}
+	return md
+}
+
+// GenerateConditions returns the patient's conditions, driven by the patient's
+// context and the incidences in the profile.  Conditions begin on or before the
+// context's as-of date, and only abate if they had abated by then.
+func GenerateConditions(r *rand.Rand, ctx Context, catalog *Catalog, p *Profile) []models.Condition {
+	md := catalog.Conditions
+	conditions := []models.Condition{}
+	if ctx.Hypertention == "Hypertension" {
+		ht := generateCondition(r, ctx.AsOf, "Hypertension", 2, catalog)
+		conditions = append(conditions, ht)
+		complication := r.Intn(5)
+		if complication == 1 {
+			chf := models.Condition{VerificationStatus: "confirmed"}
+			chfmd := conditionByName("Congestive Heart Failure", md)
+			chf.Code = chfmd.CodeableConcept(catalog.CodeSystems)
+			chf.OnsetDateTime = &models.FHIRDateTime{Time: ht.OnsetDateTime.Time.AddDate(r.Intn(2), r.Intn(10), r.Intn(28)), Precision: models.Date}
+			if !chf.OnsetDateTime.Time.After(ctx.AsOf) {
+				conditions = append(conditions, chf)
+			}
+		}
+		if complication == 2 {
+			phd := models.Condition{VerificationStatus: "confirmed"}
+			phdmd := conditionByName("Pulmonary Heart Disease", md)
+			phd.Code = phdmd.CodeableConcept(catalog.CodeSystems)
+			phd.OnsetDateTime = &models.FHIRDateTime{Time: ht.OnsetDateTime.Time.AddDate(r.Intn(2), r.Intn(10), r.Intn(28)), Precision: models.Date}
+			if !phd.OnsetDateTime.Time.After(ctx.AsOf) {
+				conditions = append(conditions, phd)
+			}
+		}
+	}
+	if ctx.Diabetes == "Diabetes" {
+		dia := generateCondition(r, ctx.AsOf, "Diabetes", 2, catalog)
+		conditions = append(conditions, dia)
+	}
+
+	previouslySelected := &intsets.Sparse{}
+	if ctx.Cholesterol == "High" || ctx.Cholesterol == "Very High" {
+		hl := generateCondition(r, ctx.AsOf, "Hyperlipidemia", 2, catalog)
+		conditions = append(conditions, hl)
+		previouslySelected.Insert(conditionByName("Hyperlipidemia", md).ID)
+	}
+
+	if ctx.Smoker == "Smoker" || ctx.Smoker == "Ex-smoker" {
+		complication := r.Intn(5)
+		if complication == 1 {
+			e := generateCondition(r, ctx.AsOf, "Emphysema", 2, catalog)
+			conditions = append(conditions, e)
+		}
+		if complication == 2 {
+			lc := generateCondition(r, ctx.AsOf, "Lung Cancer", 2, catalog)
+			conditions = append(conditions, lc)
+		}
+	}
+	// per http://www.cdc.gov/dhdsp/data_statistics/fact_sheets/fs_atrial_fibrillation.htm
+	var afibChance int
+	if ctx.AsOf.AddDate(-65, 0, 0).After(ctx.BirthDate) {
+		afibChance = p.AfibPercentOver65
+	} else {
+		afibChance = p.AfibPercentUnder65
+	}
+
+	afibDiceRoll := r.Intn(100)
+	if afibDiceRoll < afibChance {
+		afib := generateCondition(r, ctx.AsOf, "Atrial Fibrillation", 3, catalog)
+		conditions = append(conditions, afib)
+	}
+
+	for _, name := range p.sortedIncidence() {
+		incidenceDiceRoll := r.Intn(100)
+		if incidenceDiceRoll < p.ConditionIncidence[name] && !hasCondition(conditions, name) {
+			ic := generateCondition(r, ctx.AsOf, name, 1, catalog)
+			previouslySelected.Insert(conditionByName(name, md).ID)
+			conditions = append(conditions, ic)
+		}
+	}
+
+	otherConditions := p.MinOtherConditions + r.Intn(p.MaxOtherConditions-p.MinOtherConditions+1)
+	for index := 0; index < otherConditions; index++ {
+		rmd := md[r.Intn(len(md))]
+		if !previouslySelected.Has(rmd.ID) && !hasCondition(conditions, rmd.Display) {
+			rc := generateCondition(r, ctx.AsOf, rmd.Display, index, catalog)
+			previouslySelected.Insert(rmd.ID)
+			conditions = append(conditions, rc)
+		}
+	}
+
+	for i := range conditions {
+		conditions[i].Id = strconv.FormatInt(r.Int63(), 10)
+	}
+	return conditions
+}
+
+func generateCondition(r *rand.Rand, asOf time.Time, name string, yearOffset int, catalog *Catalog) models.Condition {
+	c := models.Condition{VerificationStatus: "confirmed"}
+	cmd := conditionByName(name, catalog.Conditions)
+	c.Code = cmd.CodeableConcept(catalog.CodeSystems)
+	c.OnsetDateTime = &models.FHIRDateTime{Time: randomOnset(r, yearOffset, asOf), Precision: models.Date}
+	recoveryDiceRoll := r.Intn(100)
+	if recoveryDiceRoll <= cmd.AbatementChance {
+		c.AbatementDateTime = occurredBy(recoveryDate(c.OnsetDateTime.Time, cmd.RecoveryEstimate), asOf)
+	}
+
+	return c
+}
+
+// recoveryDate returns the date a condition with the given onset and recovery
+// estimate abates, or nil if the condition has no recovery estimate
+func recoveryDate(onset time.Time, recoveryEstimate string) *models.FHIRDateTime {
+	switch recoveryEstimate {
+	case "week":
+		return &models.FHIRDateTime{Time: onset.AddDate(0, 0, 7), Precision: models.Date}
+	case "threeMonths":
+		return &models.FHIRDateTime{Time: onset.AddDate(0, 3, 0), Precision: models.Date}
+	case "sixMonths":
+		return &models.FHIRDateTime{Time: onset.AddDate(0, 6, 0), Precision: models.Date}
+	case "threeYears":
+		return &models.FHIRDateTime{Time: onset.AddDate(3, 0, 0), Precision: models.Date}
+	}
+	return nil
+}
+
+// occurredBy returns the date if it is on or before the as-of date, or nil if
+// it is nil or hasn't happened yet
+func occurredBy(d *models.FHIRDateTime, asOf time.Time) *models.FHIRDateTime {
+	if d == nil || d.Time.After(asOf) {
+		return nil
+	}
+	return d
+}
+
+// codeSystems contains the URIs of the code systems conditions can be coded
+// with, keyed by the names used to select them
+var codeSystems = map[string]string{
+	"icd9":   "http://hl7.org/fhir/sid/icd-9",
+	"icd10":  "http://hl7.org/fhir/sid/icd-10-cm",
+	"snomed": "http://snomed.info/sct",
+}
+
+// CodeSystemNames are the names of the code systems conditions can be coded
+// with, in the order their codings appear
+var CodeSystemNames = []string{"icd9", "icd10", "snomed"}
+
+// Code returns the condition's code in the named code system, or "" if the
+// condition has no code in it
+func (cmd *ConditionMetadata) Code(system string) string {
+	switch system {
+	case "icd9":
+		return cmd.ICD9
+	case "icd10":
+		return cmd.ICD10
+	case "snomed":
+		return cmd.SNOMED
+	}
+	return ""
+}
+
+// CodeableConcept returns a concept with a coding for each of the named code
+// systems the condition has a code in
+func (cmd *ConditionMetadata) CodeableConcept(systems []string) *models.CodeableConcept {
+	concept := &models.CodeableConcept{Text: cmd.Display}
+	for _, system := range systems {
+		if code := cmd.Code(system); code != "" {
+			concept.Coding = append(concept.Coding, models.Coding{Code: code, System: codeSystems[system]})
+		}
+	}
+	return concept
+}
+
+func hasCondition(conditions []models.Condition, name string) bool {
+	for _, c := range conditions {
+		if c.Code.Text == name {
+			return true
+		}
+	}
+	return false
+}
+
+func conditionByName(name string, md []ConditionMetadata) *ConditionMetadata {
+	for _, c := range md {
+		if c.Display == name {
+			return &c
+		}
+	}
+	return nil
+}
+
+func randomOnset(r *rand.Rand, minYearsAgo int, asOf time.Time) time.Time {
+	randomYears := minYearsAgo + r.Intn(3)
+	randomMonth := r.Intn(11)
+	randomDay := r.Intn(28)
+	return asOf.AddDate(-randomYears, -randomMonth, -randomDay).Truncate(time.Hour * 24)
+}
@@ -0,0 +1,111 @@
+package ptgen
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// mrnSystem is the system medical record numbers are issued in (the HL7
+// example OID, since the patients are synthetic)
+const mrnSystem = "urn:oid:2.16.840.1.113883.19.5"
+
+// raceExtension and ethnicityExtension are the URLs of the extensions
+// carrying a patient's race and ethnicity as a CodeableConcept.  They aren't
+// the US Core extensions, which nest ombCategory codings and text in
+// sub-extensions.
+const (
+	raceExtension      = "http://interventionengine.org/extensions/race"
+	ethnicityExtension = "http://interventionengine.org/extensions/ethnicity"
+	raceSystem         = "urn:oid:2.16.840.1.113883.6.238"
+)
+
+// races contains the CDC race and ethnicity code of each OMB race category,
+// keyed by the names used in profiles
+var races = map[string]string{
+	"White":                            "2106-3",
+	"Black or African American":        "2054-5",
+	"Asian":                            "2028-9",
+	"American Indian or Alaska Native": "1002-5",
+	"Native Hawaiian or Other Pacific Islander": "2076-8",
+	"Other Race": "2131-1",
+}
+
+// ethnicities contains the CDC race and ethnicity code of each OMB ethnicity
+// category, keyed by the names used in profiles
+var ethnicities = map[string]string{
+	"Hispanic or Latino":     "2135-2",
+	"Not Hispanic or Latino": "2186-5",
+}
+
+// languages contains the BCP-47 code of each language, keyed by the names used
+// in profiles
+var languages = map[string]string{
+	"English":    "en",
+	"Spanish":    "es",
+	"Chinese":    "zh",
+	"Tagalog":    "tl",
+	"Vietnamese": "vi",
+	"French":     "fr",
+	"Korean":     "ko",
+	"German":     "de",
+	"Russian":    "ru",
+	"Arabic":     "ar",
+}
+
+// maritalStatuses contains the v3 MaritalStatus code of each marital status,
+// keyed by the names used in profiles
+var maritalStatuses = map[string]string{
+	"Married":           "M",
+	"Widowed":           "W",
+	"Divorced":          "D",
+	"Legally Separated": "L",
+	"Never Married":     "S",
+}
+
+// generateIdentity gives the patient a medical record number, which is the
+// patient's generated ID so that no two patients share one, a home phone
+// number, and a mobile phone number and email address as often as the profile
+// says, along with a preferred language, a marital status, and race and
+// ethnicity extensions drawn from the profile's distributions
+func generateIdentity(r *rand.Rand, pt *models.Patient, p *Profile) {
+	pt.Identifier = []models.Identifier{{
+		Use:    "usual",
+		Type:   &models.CodeableConcept{Coding: []models.Coding{{Code: "MR", System: "http://hl7.org/fhir/v2/0203"}}, Text: "Medical record number"},
+		System: mrnSystem,
+		Value:  pt.Id,
+	}}
+
+	pt.Telecom = []models.ContactPoint{randomPhone(r, "home")}
+	mobileDiceRoll := r.Intn(100)
+	if mobileDiceRoll < p.MobilePhonePercent {
+		pt.Telecom = append(pt.Telecom, randomPhone(r, "mobile"))
+	}
+	emailDiceRoll := r.Intn(100)
+	if emailDiceRoll < p.EmailPercent {
+		name := pt.Name[0]
+		address := strings.ToLower(name.Given[0] + "." + name.Family[0])
+		pt.Telecom = append(pt.Telecom, models.ContactPoint{System: "email", Use: "home", Value: address + "@example.com"})
+	}
+
+	preferred := true
+	language := choose(r, p.Language)
+	pt.Communication = []models.PatientCommunicationComponent{{
+		Language:  &models.CodeableConcept{Coding: []models.Coding{{Code: languages[language], System: "urn:ietf:bcp:47"}}, Text: language},
+		Preferred: &preferred,
+	}}
+	pt.MaritalStatus = maritalStatus(choose(r, p.MaritalStatus))
+
+	race := choose(r, p.Race)
+	ethnicity := choose(r, p.Ethnicity)
+	pt.Extension = []models.Extension{
+		{Url: raceExtension, ValueCodeableConcept: &models.CodeableConcept{Coding: []models.Coding{{Code: races[race], System: raceSystem}}, Text: race}},
+		{Url: ethnicityExtension, ValueCodeableConcept: &models.CodeableConcept{Coding: []models.Coding{{Code: ethnicities[ethnicity], System: raceSystem}}, Text: ethnicity}},
+	}
+}
+
+// maritalStatus returns the marital status with the given name
+func maritalStatus(name string) *models.CodeableConcept {
+	return &models.CodeableConcept{Coding: []models.Coding{{Code: maritalStatuses[name], System: "http://hl7.org/fhir/v3/MaritalStatus"}}, Text: name}
+}
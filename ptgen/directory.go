@@ -0,0 +1,185 @@
+package ptgen
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// A Directory is the pool of organizations that patients are seen at, each
+// with its location and the practitioners who work there.  Each patient is
+// assigned a primary care practitioner at one of the clinics, who sees the
+// patient at office visits, and a hospital, whose practitioners attend the
+// patient's inpatient stays.
+type Directory struct {
+	Clinics   []Provider
+	Hospitals []Provider
+}
+
+// A Provider is an organization, the location at which it sees patients and
+// the practitioners who work there
+type Provider struct {
+	Organization  models.Organization
+	Location      models.Location
+	Practitioners []models.Practitioner
+}
+
+// GenerateDirectory generates a directory of the given number of clinics and
+// hospitals in the given places, each with the given number of practitioners.
+// Resources in the directory reference each other with "cid:" references, like
+// the resources generated for a patient, so the directory must be uploaded (or
+// otherwise assigned its final IDs) before patients are assigned to it.
+func GenerateDirectory(r *rand.Rand, places []Place, clinics, hospitals, practitioners int) (*Directory, error) {
+	if len(places) == 0 {
+		return nil, fmt.Errorf("a directory needs at least one place")
+	}
+	if clinics < 1 || hospitals < 1 || practitioners < 1 {
+		return nil, fmt.Errorf("a directory needs at least one clinic, one hospital and one practitioner per organization")
+	}
+	d := &Directory{}
+	for i := 0; i < clinics; i++ {
+		d.Clinics = append(d.Clinics, generateProvider(r, places, "Family Medicine", "FMC", "Family medicine clinic", practitioners))
+	}
+	for i := 0; i < hospitals; i++ {
+		d.Hospitals = append(d.Hospitals, generateProvider(r, places, "General Hospital", "HOSP", "Hospital", practitioners))
+	}
+	return d, nil
+}
+
+func generateProvider(r *rand.Rand, places []Place, suffix, locationCode, locationDisplay string, practitioners int) Provider {
+	place := choosePlace(r, places)
+	name := place.City + " " + suffix
+	address := place.address(r)
+	phone := randomPhone(r, "work")
+	active := true
+
+	p := Provider{}
+	p.Organization.Id = strconv.FormatInt(r.Int63(), 10)
+	p.Organization.Active = &active
+	p.Organization.Name = name
+	p.Organization.Type = &models.CodeableConcept{Coding: []models.Coding{{Code: "prov", System: "http://hl7.org/fhir/organization-type"}}, Text: "Healthcare Provider"}
+	p.Organization.Address = []models.Address{address}
+	p.Organization.Telecom = []models.ContactPoint{phone}
+
+	p.Location.Id = strconv.FormatInt(r.Int63(), 10)
+	p.Location.Status = "active"
+	p.Location.Mode = "instance"
+	p.Location.Name = name
+	p.Location.Type = &models.CodeableConcept{Coding: []models.Coding{{Code: locationCode, System: "http://hl7.org/fhir/v3/RoleCode"}}, Text: locationDisplay}
+	p.Location.Address = &address
+	p.Location.Position = place.position()
+	p.Location.Telecom = []models.ContactPoint{phone}
+	p.Location.ManagingOrganization = &models.Reference{Reference: "cid:" + p.Organization.Id}
+
+	specialty := &models.CodeableConcept{Coding: []models.Coding{{Code: "419772000", System: "http://snomed.info/sct"}}, Text: "Family practice"}
+	if locationCode == "HOSP" {
+		specialty = &models.CodeableConcept{Coding: []models.Coding{{Code: "419192003", System: "http://snomed.info/sct"}}, Text: "Internal medicine"}
+	}
+	for i := 0; i < practitioners; i++ {
+		p.Practitioners = append(p.Practitioners, generatePractitioner(r, &p, specialty))
+	}
+	return p
+}
+
+func generatePractitioner(r *rand.Rand, p *Provider, specialty *models.CodeableConcept) models.Practitioner {
+	active := true
+	pr := models.Practitioner{Active: &active}
+	pr.Id = strconv.FormatInt(r.Int63(), 10)
+	pr.Gender = choiceString(r, []string{"male", "female"})
+	pr.Name = &models.HumanName{
+		Prefix: []string{"Dr."},
+		Given:  []string{fakeSample(r, pr.Gender+"_first_names")},
+		Family: []string{fakeSample(r, pr.Gender+"_last_names")},
+	}
+	pr.Identifier = []models.Identifier{{System: "http://hl7.org/fhir/sid/us-npi", Value: "1" + randomDigits(r, 9)}}
+	pr.PractitionerRole = []models.PractitionerPractitionerRoleComponent{{
+		ManagingOrganization: &models.Reference{Reference: "cid:" + p.Organization.Id},
+		Role:                 &models.CodeableConcept{Coding: []models.Coding{{Code: "doctor", System: "http://hl7.org/fhir/practitioner-role"}}, Text: "Doctor"},
+		Specialty:            []models.CodeableConcept{*specialty},
+		Location:             []models.Reference{{Reference: "cid:" + p.Location.Id}},
+	}}
+	return pr
+}
+
+// randomPhone returns a fictional (555) phone number with the given use
+func randomPhone(r *rand.Rand, use string) models.ContactPoint {
+	return models.ContactPoint{System: "phone", Use: use, Value: randomDigits(r, 3) + "-555-" + randomDigits(r, 4)}
+}
+
+// Resources returns the organizations, locations and practitioners in the
+// directory.  The resources are pointers into the directory, so changes to
+// their IDs (e.g., when they are uploaded) are seen by the patients assigned
+// to the directory afterward.
+func (d *Directory) Resources() []interface{} {
+	var resources []interface{}
+	for _, providers := range [][]Provider{d.Clinics, d.Hospitals} {
+		for i := range providers {
+			p := &providers[i]
+			resources = append(resources, &p.Organization, &p.Location)
+			for j := range p.Practitioners {
+				resources = append(resources, &p.Practitioners[j])
+			}
+		}
+	}
+	return resources
+}
+
+// Assign assigns the patient to a primary care practitioner at one of the
+// clinics and to one of the hospitals.  The practitioner and clinic become the
+// patient's care providers, assert the patient's conditions, record the
+// patient's allergies, report and prescribe the patient's medications,
+// immunize the patient and see the patient at office visits, while emergency
+// visits and inpatient stays take place at the hospital and are attended by
+// one of its practitioners.  References to the directory use the directory
+// resources' current IDs.
+func (d *Directory) Assign(r *rand.Rand, resources []interface{}) {
+	clinic := &d.Clinics[r.Intn(len(d.Clinics))]
+	pcp := &clinic.Practitioners[r.Intn(len(clinic.Practitioners))]
+	hospital := &d.Hospitals[r.Intn(len(d.Hospitals))]
+	for _, resource := range resources {
+		switch t := resource.(type) {
+		case *models.Patient:
+			t.CareProvider = []models.Reference{*practitionerReference(pcp), *organizationReference(&clinic.Organization)}
+		case *models.Condition:
+			t.Asserter = practitionerReference(pcp)
+		case *models.MedicationStatement:
+			t.InformationSource = practitionerReference(pcp)
+		case *models.MedicationOrder:
+			t.Prescriber = practitionerReference(pcp)
+		case *models.AllergyIntolerance:
+			t.Recorder = practitionerReference(pcp)
+		case *models.Immunization:
+			t.Requester = practitionerReference(pcp)
+			if !*t.WasNotGiven {
+				t.Performer = practitionerReference(pcp)
+				t.Location = &models.Reference{Reference: "Location/" + clinic.Location.Id, Display: clinic.Location.Name}
+			}
+		case *models.Encounter:
+			if t.Class == "inpatient" || t.Class == "emergency" {
+				attending := &hospital.Practitioners[r.Intn(len(hospital.Practitioners))]
+				assignEncounter(t, hospital, attending, "ATND", "attender")
+			} else {
+				assignEncounter(t, clinic, pcp, "PPRF", "primary performer")
+			}
+		}
+	}
+}
+
+func assignEncounter(e *models.Encounter, p *Provider, pr *models.Practitioner, participationCode, participationDisplay string) {
+	e.Participant = []models.EncounterParticipantComponent{{
+		Type:       []models.CodeableConcept{{Coding: []models.Coding{{Code: participationCode, System: "http://hl7.org/fhir/v3/ParticipationType"}}, Text: participationDisplay}},
+		Individual: practitionerReference(pr),
+	}}
+	e.ServiceProvider = organizationReference(&p.Organization)
+	e.Location = []models.EncounterLocationComponent{{Location: &models.Reference{Reference: "Location/" + p.Location.Id, Display: p.Location.Name}}}
+}
+
+func practitionerReference(pr *models.Practitioner) *models.Reference {
+	return &models.Reference{Reference: "Practitioner/" + pr.Id, Display: pr.Name.Prefix[0] + " " + pr.Name.Given[0] + " " + pr.Name.Family[0]}
+}
+
+func organizationReference(o *models.Organization) *models.Reference {
+	return &models.Reference{Reference: "Organization/" + o.Id, Display: o.Name}
+}
@@ -0,0 +1,290 @@
+package ptgen
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// GenerateOfficeVisit returns an office visit encounter on the given date. If
+// the visit is to follow up on a condition, the condition is referenced as the
+// indication for the visit.
+func GenerateOfficeVisit(r *rand.Rand, date time.Time, c *models.Condition) *models.Encounter {
+	e := &models.Encounter{Status: "finished", Class: "outpatient"}
+	e.Id = strconv.FormatInt(r.Int63(), 10)
+	e.Type = []models.CodeableConcept{{Coding: []models.Coding{{Code: "99213", System: "http://www.ama-assn.org/go/cpt"}}, Text: "Office Visit"}}
+	e.Period = &models.Period{Start: &models.FHIRDateTime{Time: date, Precision: models.Date}}
+	if c != nil {
+		e.Reason = []models.CodeableConcept{*c.Code}
+		e.Indication = []models.Reference{{Reference: "cid:" + c.Id}}
+	}
+	return e
+}
+
+// wellnessVisitYears is the number of past years patients have a yearly
+// wellness visit in
+const wellnessVisitYears = 3
+
+// adultAge is the age from which a lipid panel is part of a wellness visit
+const adultAge = 18
+
+// WellnessVisitDates returns the dates of the patient's yearly wellness
+// visits, one on a random day in each of the three years before the as-of
+// date, in order.  Visits only take place while the patient is alive.
+func WellnessVisitDates(r *rand.Rand, birthDate time.Time, deathDate *models.FHIRDateTime, asOf time.Time) []time.Time {
+	var dates []time.Time
+	for i := wellnessVisitYears; i > 0; i-- {
+		t := asOf.AddDate(-i, 0, 1+r.Intn(365)).Truncate(time.Hour * 24)
+		if t.After(asOf) || t.Before(birthDate) || !aliveOn(t, deathDate) {
+			continue
+		}
+		dates = append(dates, t)
+	}
+	return dates
+}
+
+// GenerateWellnessObservations returns the vitals and labs recorded at a
+// wellness visit: blood pressure, blood sugars, weight and height, smoking
+// status and alcohol use, and a lipid panel for adults.
+func GenerateWellnessObservations(r *rand.Rand, ctx Context, date time.Time) []models.Observation {
+	obs := GenerateBP(r, ctx, date)
+	obs = append(obs, GenerateBloodSugars(r, ctx, date)...)
+	obs = append(obs, GenerateWeightAndHeight(r, ctx, date)...)
+	if ageOn(ctx.BirthDate, date) >= adultAge {
+		obs = append(obs, GenerateCholesterol(r, ctx, date)...)
+	}
+	return append(obs, GenerateSocialHistory(ctx, date)...)
+}
+
+// FollowUpDates returns the dates of the visits following up on a condition,
+// according to the condition's check up schedule.  A "weekLater" check up is a
+// week after onset and an "ifProcedure-weekLater" check up is a week after the
+// procedure, each followed by a visit every three months.  An
+// "ifProcedure-CastRemoval" check up is six weeks after the procedure.
+// "chemotherapy" check ups are six three-week cycles starting a week after
+// onset, followed by a visit every three months.  If the schedule depends on a
+// procedure and no procedure was performed, there are no check ups.  Chronic
+// conditions are also seen every three months from onset whenever their check
+// ups don't already bring the patient in, so each chronic condition adds
+// visits of its own.  Follow-ups continue until the condition abates, the
+// patient dies, or the as-of date, whichever comes first.
+func FollowUpDates(c *models.Condition, cmd *ConditionMetadata, procedure *models.Procedure, deathDate *models.FHIRDateTime, asOf time.Time) []time.Time {
+	if cmd == nil {
+		return nil
+	}
+	end := asOf
+	if c.AbatementDateTime != nil && c.AbatementDateTime.Time.Before(end) {
+		end = c.AbatementDateTime.Time
+	}
+	if deathDate != nil && deathDate.Time.Before(end) {
+		end = deathDate.Time
+	}
+
+	onset := c.OnsetDateTime.Time
+	var checkUps []time.Time
+	switch cmd.CheckUp {
+	case "weekLater":
+		checkUps = visitDates(onset.AddDate(0, 0, 7), 1, 0, end)
+	case "ifProcedure-weekLater":
+		if procedure != nil {
+			checkUps = visitDates(procedure.PerformedDateTime.Time.AddDate(0, 0, 7), 1, 0, end)
+		}
+	case "ifProcedure-CastRemoval":
+		if procedure != nil {
+			castRemoval := procedure.PerformedDateTime.Time.AddDate(0, 0, 6*7)
+			if !castRemoval.After(asOf) && aliveOn(castRemoval, deathDate) {
+				checkUps = []time.Time{castRemoval}
+			}
+		}
+	case "chemotherapy":
+		checkUps = visitDates(onset.AddDate(0, 0, 7), 6, 21, end)
+	}
+	if !cmd.Chronic {
+		return checkUps
+	}
+	routine := visitDates(onset.AddDate(0, 3, 0), 1, 0, end)
+	if len(checkUps) == 0 {
+		return routine
+	}
+
+	// Routine visits for a chronic condition stop when its check ups start,
+	// and resume three months after the last of them
+	first, last := checkUps[0], checkUps[len(checkUps)-1].AddDate(0, 3, 0)
+	var before, after []time.Time
+	for _, t := range routine {
+		if t.Before(first) {
+			before = append(before, t)
+		} else if !t.Before(last) {
+			after = append(after, t)
+		}
+	}
+	return append(append(before, checkUps...), after...)
+}
+
+// visitDates returns a visit on the first date, followed by visits every
+// cycleDays for the given number of cycles, followed by visits every three
+// months, until the end date
+func visitDates(first time.Time, cycles, cycleDays int, end time.Time) []time.Time {
+	var dates []time.Time
+	for i, t := 0, first; !t.After(end); i++ {
+		dates = append(dates, t)
+		if i < cycles-1 {
+			t = t.AddDate(0, 0, cycleDays)
+		} else {
+			t = t.AddDate(0, 3, 0)
+		}
+	}
+	return dates
+}
+
+// GenerateFollowUpObservations returns the vitals and labs relevant to a
+// visit following up on the given condition.  Weight and height are always
+// measured, blood pressure is measured for cardiovascular conditions, blood
+// sugars are measured for diabetes, and a lipid panel is measured for
+// conditions related to cholesterol.
+func GenerateFollowUpObservations(r *rand.Rand, ctx Context, cmd *ConditionMetadata, date time.Time) []models.Observation {
+	obs := GenerateWeightAndHeight(r, ctx, date)
+	if cardiovascularConditions[cmd.Display] {
+		obs = append(obs, GenerateBP(r, ctx, date)...)
+	}
+	if cmd.Display == "Diabetes" {
+		obs = append(obs, GenerateBloodSugars(r, ctx, date)...)
+	}
+	if lipidConditions[cmd.Display] {
+		obs = append(obs, GenerateCholesterol(r, ctx, date)...)
+	}
+	return obs
+}
+
+var lipidConditions = map[string]bool{
+	"Hyperlipidemia": true,
+	"Athersclerosis": true,
+}
+
+var cardiovascularConditions = map[string]bool{
+	"Hypertension":                       true,
+	"Congestive Heart Failure":           true,
+	"Athersclerosis":                     true,
+	"Pulmonary Heart Disease":            true,
+	"Stroke without Cerebral Infarction": true,
+	"Stroke with Cerebral Infarction":    true,
+	"Intracranial Hemorrhaging":          true,
+	"Atrial Fibrillation":                true,
+}
+
+// GenerateInpatientStay returns an inpatient encounter admitting the patient
+// for the condition, or nil if the condition does not require an overnight
+// stay.  The patient is admitted on the onset of the condition and stays for
+// a number of nights within the range of the condition's overnights.  If the
+// patient dies during the stay, the patient is discharged as expired.  Stays
+// are not admitted after the as-of date.
+func GenerateInpatientStay(r *rand.Rand, c *models.Condition, cmd *ConditionMetadata, deathDate *models.FHIRDateTime, asOf time.Time) *models.Encounter {
+	if cmd == nil {
+		return nil
+	}
+	nights := overnights(r, cmd.Overnights)
+	admit := c.OnsetDateTime.Time
+	if nights == 0 || admit.After(asOf) || !aliveOn(admit, deathDate) {
+		return nil
+	}
+
+	return newInpatientStay(r, admit, nights, c, deathDate, asOf)
+}
+
+// newInpatientStay returns an inpatient encounter admitting the patient on the
+// given date for the given number of nights, with the condition (if any) as
+// the admitting diagnosis.  If the patient dies during the stay, the patient
+// is discharged as expired, and if the stay hasn't ended by the as-of date, it
+// is still in progress.
+func newInpatientStay(r *rand.Rand, admit time.Time, nights int, c *models.Condition, deathDate *models.FHIRDateTime, asOf time.Time) *models.Encounter {
+	e := &models.Encounter{Class: "inpatient"}
+	e.Id = strconv.FormatInt(r.Int63(), 10)
+	e.Type = []models.CodeableConcept{{Coding: []models.Coding{{Code: "32485007", System: "http://snomed.info/sct"}}, Text: "Hospital Admission"}}
+	e.Period = &models.Period{Start: &models.FHIRDateTime{Time: admit, Precision: models.Date}}
+	e.Hospitalization = &models.EncounterHospitalizationComponent{}
+	if c != nil {
+		e.Hospitalization.AdmittingDiagnosis = []models.Reference{{Reference: "cid:" + c.Id}}
+	}
+
+	discharge := admit.AddDate(0, 0, nights)
+	switch {
+	case !aliveOn(discharge, deathDate):
+		e.Status = "finished"
+		e.Period.End = deathDate
+		e.Hospitalization.DischargeDisposition = dischargeDisposition("exp", "Expired")
+	case discharge.After(asOf):
+		e.Status = "in-progress"
+	default:
+		e.Status = "finished"
+		e.Period.End = &models.FHIRDateTime{Time: discharge, Precision: models.Date}
+		e.Hospitalization.DischargeDisposition = dischargeDisposition("home", "Home")
+	}
+	if e.Period.End != nil {
+		days := float64(int(e.Period.End.Time.Sub(admit).Hours()/24 + 0.5))
+		e.Length = newQuantity(days, "days")
+	}
+
+	return e
+}
+
+// GenerateInpatientObservations returns the observations taken during an
+// inpatient stay: a full set of vitals and labs and the patient's social
+// history on admission, followed by a daily blood pressure for each day of the
+// stay (up to the context's as-of date, if the stay is still in progress).
+func GenerateInpatientObservations(r *rand.Rand, ctx Context, stay *models.Encounter) []models.Observation {
+	admit := stay.Period.Start.Time
+	obs := GenerateBP(r, ctx, admit)
+	obs = append(obs, GenerateBloodSugars(r, ctx, admit)...)
+	obs = append(obs, GenerateWeightAndHeight(r, ctx, admit)...)
+	obs = append(obs, GenerateSocialHistory(ctx, admit)...)
+
+	end := ctx.AsOf
+	if stay.Period.End != nil {
+		end = stay.Period.End.Time
+	}
+	for day := admit.AddDate(0, 0, 1); !day.After(end); day = day.AddDate(0, 0, 1) {
+		obs = append(obs, GenerateBP(r, ctx, day)...)
+	}
+	return obs
+}
+
+// overnights returns a random number of nights within an overnights range
+// from the condition metadata, such as "3-5".  A range that cannot be parsed
+// results in no nights.
+func overnights(r *rand.Rand, overnightsRange string) int {
+	min, max, err := parseOvernights(overnightsRange)
+	if err != nil {
+		return 0
+	}
+	return min + r.Intn(max-min+1)
+}
+
+// parseOvernights parses an overnights range, which is either a single number
+// of nights or a range of nights, such as "3-5"
+func parseOvernights(overnightsRange string) (min, max int, err error) {
+	bounds := strings.Split(overnightsRange, "-")
+	if len(bounds) > 2 {
+		return 0, 0, fmt.Errorf("invalid overnights range %q", overnightsRange)
+	}
+	if min, err = strconv.Atoi(bounds[0]); err != nil {
+		return 0, 0, err
+	}
+	max = min
+	if len(bounds) == 2 {
+		if max, err = strconv.Atoi(bounds[1]); err != nil {
+			return 0, 0, err
+		}
+	}
+	if min < 0 || max < min {
+		return 0, 0, fmt.Errorf("invalid overnights range %q", overnightsRange)
+	}
+	return min, max, nil
+}
+
+func dischargeDisposition(code, display string) *models.CodeableConcept {
+	return &models.CodeableConcept{Coding: []models.Coding{{Code: code, System: "http://hl7.org/fhir/discharge-disposition"}}, Text: display}
+}
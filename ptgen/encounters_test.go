@@ -0,0 +1,185 @@
+package ptgen
+
+import (
+	"testing"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+func condition(onset time.Time) *models.Condition {
+	return &models.Condition{OnsetDateTime: &models.FHIRDateTime{Time: onset, Precision: models.Date}}
+}
+
+func TestChronicConditionsAreSeenEveryThreeMonths(t *testing.T) {
+	onset, asOf := day(2014, time.January, 1), day(2015, time.January, 1)
+	dates := FollowUpDates(condition(onset), &ConditionMetadata{CheckUp: "none", Chronic: true}, nil, nil, asOf)
+	if len(dates) != 4 {
+		t.Fatalf("Expected four visits in the year after onset, got %v", dates)
+	}
+	for i, date := range dates {
+		if expected := onset.AddDate(0, 3*(i+1), 0); !date.Equal(expected) {
+			t.Errorf("Expected visit %d on %s, got %s", i, expected, date)
+		}
+	}
+
+	if dates := FollowUpDates(condition(onset), &ConditionMetadata{CheckUp: "none"}, nil, nil, asOf); len(dates) != 0 {
+		t.Errorf("Expected no visits for a condition that isn't chronic, got %v", dates)
+	}
+
+	abated := condition(onset)
+	abated.AbatementDateTime = &models.FHIRDateTime{Time: day(2014, time.May, 1), Precision: models.Date}
+	if dates := FollowUpDates(abated, &ConditionMetadata{CheckUp: "none", Chronic: true}, nil, nil, asOf); len(dates) != 1 {
+		t.Errorf("Expected visits to stop when the condition abates, got %v", dates)
+	}
+}
+
+func TestChronicVisitsGiveWayToCheckUps(t *testing.T) {
+	onset, asOf := day(2014, time.January, 1), day(2015, time.January, 1)
+	procedure := &models.Procedure{PerformedDateTime: &models.FHIRDateTime{Time: day(2014, time.May, 1), Precision: models.Date}}
+	cmd := &ConditionMetadata{CheckUp: "ifProcedure-weekLater", Chronic: true}
+	dates := FollowUpDates(condition(onset), cmd, procedure, nil, asOf)
+	expected := []time.Time{
+		day(2014, time.April, 1),
+		day(2014, time.May, 8),
+		day(2014, time.August, 8),
+		day(2014, time.November, 8),
+	}
+	if len(dates) != len(expected) {
+		t.Fatalf("Expected visits on %v, got %v", expected, dates)
+	}
+	for i := range expected {
+		if !dates[i].Equal(expected[i]) {
+			t.Errorf("Expected visit %d on %s, got %s", i, expected[i], dates[i])
+		}
+	}
+}
+
+func TestWellnessVisitsAreYearly(t *testing.T) {
+	asOf := day(2016, time.June, 1)
+	dates := WellnessVisitDates(NewRand(42), day(1940, time.March, 3), nil, asOf)
+	if len(dates) != wellnessVisitYears {
+		t.Fatalf("Expected %d wellness visits, got %v", wellnessVisitYears, dates)
+	}
+	for i, date := range dates {
+		yearStart := asOf.AddDate(i-wellnessVisitYears, 0, 0)
+		if !date.After(yearStart) || date.After(yearStart.AddDate(1, 0, 0)) {
+			t.Errorf("Expected visit %d in the year after %s, got %s", i, yearStart, date)
+		}
+	}
+
+	death := &models.FHIRDateTime{Time: asOf.AddDate(-wellnessVisitYears, 0, 0), Precision: models.Date}
+	if dates := WellnessVisitDates(NewRand(42), day(1940, time.March, 3), death, asOf); len(dates) != 0 {
+		t.Errorf("Expected no visits after death, got %v", dates)
+	}
+	if dates := WellnessVisitDates(NewRand(42), asOf.AddDate(-1, 0, 0), nil, asOf); len(dates) > 1 {
+		t.Errorf("Expected no visits before birth, got %v", dates)
+	}
+}
+
+func TestWellnessVisitsMeasureLipidsForAdults(t *testing.T) {
+	date := day(2016, time.June, 1)
+	hasLipids := func(obs []models.Observation) bool {
+		for _, o := range obs {
+			if o.Code.Coding[0].Code == "13457-7" {
+				return true
+			}
+		}
+		return false
+	}
+	ctx := NewContext(NewRand(42), DefaultProfile())
+	ctx.BirthDate = day(1950, time.March, 3)
+	if obs := GenerateWellnessObservations(NewRand(42), ctx, date); !hasLipids(obs) {
+		t.Error("Expected a lipid panel at an adult's wellness visit")
+	}
+	ctx.BirthDate = day(2006, time.March, 3)
+	if obs := GenerateWellnessObservations(NewRand(42), ctx, date); hasLipids(obs) {
+		t.Error("Expected no lipid panel at a child's wellness visit")
+	}
+}
+
+func TestInpatientStaysAreDischargedHome(t *testing.T) {
+	admit, asOf := day(2015, time.March, 1), day(2016, time.January, 1)
+	c := condition(admit)
+	c.Id = "c"
+	stay := GenerateInpatientStay(NewRand(42), c, &ConditionMetadata{Overnights: "4"}, nil, asOf)
+	if stay == nil {
+		t.Fatal("Expected an inpatient stay")
+	}
+	if stay.Status != "finished" || !stay.Period.End.Time.Equal(day(2015, time.March, 5)) {
+		t.Errorf("Expected the stay to end after four nights, got %s ending %v", stay.Status, stay.Period.End)
+	}
+	if code := stay.Hospitalization.DischargeDisposition.Coding[0].Code; code != "home" {
+		t.Errorf("Expected the patient to be discharged home, got %s", code)
+	}
+	if *stay.Length.Value != 4 {
+		t.Errorf("Expected a length of four days, got %v", *stay.Length.Value)
+	}
+	if len(stay.Hospitalization.AdmittingDiagnosis) != 1 || stay.Hospitalization.AdmittingDiagnosis[0].Reference != "cid:c" {
+		t.Errorf("Expected the condition as the admitting diagnosis, got %v", stay.Hospitalization.AdmittingDiagnosis)
+	}
+
+	if stay := GenerateInpatientStay(NewRand(42), c, &ConditionMetadata{Overnights: "0"}, nil, asOf); stay != nil {
+		t.Errorf("Expected no stay for a condition without overnights, got %v", stay)
+	}
+	if stay := GenerateInpatientStay(NewRand(42), condition(asOf.AddDate(0, 0, 1)), &ConditionMetadata{Overnights: "4"}, nil, asOf); stay != nil {
+		t.Errorf("Expected no stay admitted after the as-of date, got %v", stay)
+	}
+}
+
+func TestInpatientStaysEndWhenThePatientDies(t *testing.T) {
+	admit, asOf := day(2015, time.March, 1), day(2016, time.January, 1)
+	death := &models.FHIRDateTime{Time: day(2015, time.March, 3), Precision: models.Date}
+	stay := newInpatientStay(NewRand(42), admit, 4, nil, death, asOf)
+	if stay.Status != "finished" || !stay.Period.End.Time.Equal(death.Time) {
+		t.Errorf("Expected the stay to end on the death, got %s ending %v", stay.Status, stay.Period.End)
+	}
+	if code := stay.Hospitalization.DischargeDisposition.Coding[0].Code; code != "exp" {
+		t.Errorf("Expected the patient to be discharged as expired, got %s", code)
+	}
+	if *stay.Length.Value != 2 {
+		t.Errorf("Expected a length of two days, got %v", *stay.Length.Value)
+	}
+}
+
+func TestInpatientStaysCanBeInProgress(t *testing.T) {
+	admit, asOf := day(2015, time.December, 30), day(2016, time.January, 1)
+	stay := newInpatientStay(NewRand(42), admit, 4, nil, nil, asOf)
+	if stay.Status != "in-progress" || stay.Period.End != nil {
+		t.Errorf("Expected the stay to be in progress, got %s ending %v", stay.Status, stay.Period.End)
+	}
+	if stay.Hospitalization.DischargeDisposition != nil || stay.Length != nil {
+		t.Errorf("Expected no discharge or length for a stay in progress, got %v and %v", stay.Hospitalization.DischargeDisposition, stay.Length)
+	}
+}
+
+func TestInpatientStaysMeasureBloodPressureDaily(t *testing.T) {
+	countBP := func(obs []models.Observation) map[time.Time]int {
+		counts := make(map[time.Time]int)
+		for _, o := range obs {
+			if o.Code.Coding[0].Code == "8480-6" {
+				counts[o.EffectiveDateTime.Time]++
+			}
+		}
+		return counts
+	}
+	admit := day(2015, time.December, 28)
+	ctx := NewContext(NewRand(42), DefaultProfile())
+	ctx.BirthDate, ctx.AsOf = day(1940, time.March, 3), day(2016, time.January, 1)
+
+	stay := newInpatientStay(NewRand(42), admit, 2, nil, nil, ctx.AsOf)
+	counts := countBP(GenerateInpatientObservations(NewRand(42), ctx, stay))
+	if len(counts) != 3 {
+		t.Errorf("Expected blood pressures on admission and each of two days, got %v", counts)
+	}
+	for d := 0; d <= 2; d++ {
+		if date := admit.AddDate(0, 0, d); counts[date] != 1 {
+			t.Errorf("Expected one blood pressure on %s, got %d", date, counts[date])
+		}
+	}
+
+	stay = newInpatientStay(NewRand(42), admit, 10, nil, nil, ctx.AsOf)
+	if counts := countBP(GenerateInpatientObservations(NewRand(42), ctx, stay)); len(counts) != 5 {
+		t.Errorf("Expected daily blood pressures up to the as-of date for a stay in progress, got %v", counts)
+	}
+}
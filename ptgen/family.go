@@ -0,0 +1,215 @@
+package ptgen
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// A Relative is a member of the patient's family.  Conditions lists the
+// heritable conditions the relative has had, keyed by name, with the age at
+// which each began.
+type Relative struct {
+	Relationship string
+	Gender       string
+	BirthDate    time.Time
+	Deceased     bool
+	Conditions   map[string]int
+}
+
+// heritableConditions are the conditions that run in families, with the
+// percentage of relatives who have them
+var heritableConditions = []struct {
+	Name    string
+	Percent int
+	MinAge  int
+	MaxAge  int
+}{
+	{"Hypertension", 40, 40, 70},
+	{"Diabetes", 25, 35, 70},
+	{"Atrial Fibrillation", 10, 55, 85},
+}
+
+// familyAfibPercent is the increase in the chance of atrial fibrillation for
+// each relative who has had it
+const familyAfibPercent = 5
+
+// relationships contains the display of each v3 RoleCode used for relatives
+var relationships = map[string]string{
+	"FTH":  "father",
+	"MTH":  "mother",
+	"BRO":  "brother",
+	"SIS":  "sister",
+	"HUSB": "husband",
+	"WIFE": "wife",
+	"SONC": "son",
+	"DAUC": "daughter",
+}
+
+// GenerateParentsAndSiblings returns the patient's parents and zero to three
+// siblings, each of whom may have had heritable conditions by the as-of date.
+// Siblings born after the as-of date are left out.
+func GenerateParentsAndSiblings(r *rand.Rand, birthDate, asOf time.Time) []Relative {
+	var relatives []Relative
+	for _, rel := range []string{"FTH", "MTH"} {
+		relatives = append(relatives, generateRelative(r, rel, birthDate.AddDate(-18-r.Intn(22), -r.Intn(12), 0), asOf))
+	}
+	siblings := r.Intn(4)
+	for i := 0; i < siblings; i++ {
+		rel := choiceString(r, []string{"BRO", "SIS"})
+		sibling := generateRelative(r, rel, birthDate.AddDate(r.Intn(21)-10, -r.Intn(12), 0), asOf)
+		if !sibling.BirthDate.After(asOf) {
+			relatives = append(relatives, sibling)
+		}
+	}
+	return relatives
+}
+
+func generateRelative(r *rand.Rand, relationship string, birthDate, asOf time.Time) Relative {
+	rel := Relative{Relationship: relationship, BirthDate: birthDate.Truncate(time.Hour * 24), Conditions: make(map[string]int)}
+	rel.Gender = "male"
+	if relationship == "MTH" || relationship == "SIS" || relationship == "WIFE" || relationship == "DAUC" {
+		rel.Gender = "female"
+	}
+	age := ageOn(rel.BirthDate, asOf)
+	for _, hc := range heritableConditions {
+		heritableDiceRoll := r.Intn(100)
+		if heritableDiceRoll < hc.Percent && age > hc.MinAge {
+			onsetAge := hc.MinAge + r.Intn(hc.MaxAge-hc.MinAge)
+			if onsetAge < age {
+				rel.Conditions[hc.Name] = onsetAge
+			}
+		}
+	}
+	deathDiceRoll := r.Intn(100)
+	rel.Deceased = age >= 95 || (age >= 70 && deathDiceRoll < age-40)
+	return rel
+}
+
+// WithFamilyHistory returns a copy of the profile in which the patient's risk
+// of each heritable condition is raised by the relatives who have had it.
+// Each affected relative adds the profile's weight for the condition's state
+// again, and adds to the chance of atrial fibrillation.
+func (p *Profile) WithFamilyHistory(relatives []Relative) *Profile {
+	fp := *p
+	fp.Hypertension = copyWeights(p.Hypertension)
+	fp.Diabetes = copyWeights(p.Diabetes)
+	for _, rel := range relatives {
+		if _, ok := rel.Conditions["Hypertension"]; ok {
+			fp.Hypertension["Hypertension"] += p.Hypertension["Hypertension"]
+		}
+		if _, ok := rel.Conditions["Diabetes"]; ok {
+			fp.Diabetes["Diabetes"] += p.Diabetes["Diabetes"]
+		}
+		if _, ok := rel.Conditions["Atrial Fibrillation"]; ok {
+			fp.AfibPercentUnder65 = minInt(fp.AfibPercentUnder65+familyAfibPercent, 100)
+			fp.AfibPercentOver65 = minInt(fp.AfibPercentOver65+familyAfibPercent, 100)
+		}
+	}
+	return &fp
+}
+
+func copyWeights(weights map[string]int) map[string]int {
+	c := make(map[string]int, len(weights))
+	for k, v := range weights {
+		c[k] = v
+	}
+	return c
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// GenerateFamilyMemberHistories returns a family member history, recorded on
+// the as-of date, for each relative who has had a heritable condition
+func GenerateFamilyMemberHistories(relatives []Relative, md []ConditionMetadata, systems []string, asOf time.Time) []models.FamilyMemberHistory {
+	var histories []models.FamilyMemberHistory
+	for _, rel := range relatives {
+		if len(rel.Conditions) == 0 {
+			continue
+		}
+		fmh := models.FamilyMemberHistory{Status: "completed", Gender: rel.Gender}
+		fmh.Date = &models.FHIRDateTime{Time: asOf, Precision: models.Date}
+		fmh.Relationship = roleCode(rel.Relationship)
+		fmh.BornDate = &models.FHIRDateTime{Time: rel.BirthDate, Precision: models.Date}
+		deceased := rel.Deceased
+		fmh.DeceasedBoolean = &deceased
+		for _, hc := range heritableConditions {
+			onsetAge, ok := rel.Conditions[hc.Name]
+			if !ok {
+				continue
+			}
+			age := float64(onsetAge)
+			fmh.Condition = append(fmh.Condition, models.FamilyMemberHistoryConditionComponent{
+				Code:     conditionByName(hc.Name, md).CodeableConcept(systems),
+				OnsetAge: newQuantity(age, "years"),
+			})
+		}
+		histories = append(histories, fmh)
+	}
+	return histories
+}
+
+// GenerateHousehold returns the adult patient's spouse and zero to three
+// children.  The spouse and any children under 18 live with the patient;
+// adult children live elsewhere, in one of the given places.  Children born
+// after the as-of date are left out.
+func GenerateHousehold(r *rand.Rand, pt models.Patient, places []Place, asOf time.Time) []models.RelatedPerson {
+	var household []models.RelatedPerson
+	spouseRel, spouseGender := "WIFE", "female"
+	if pt.Gender == "female" {
+		spouseRel, spouseGender = "HUSB", "male"
+	}
+	spouse := newRelatedPerson(r, spouseRel, spouseGender, pt, pt.BirthDate.Time.AddDate(r.Intn(11)-5, -r.Intn(12), -r.Intn(28)))
+	spouse.Address = pt.Address
+	household = append(household, spouse)
+
+	children := r.Intn(4)
+	for i := 0; i < children; i++ {
+		rel, gender := "SONC", "male"
+		if r.Intn(2) == 0 {
+			rel, gender = "DAUC", "female"
+		}
+		child := newRelatedPerson(r, rel, gender, pt, pt.BirthDate.Time.AddDate(20+r.Intn(21), -r.Intn(12), -r.Intn(28)))
+		if child.BirthDate.Time.After(asOf) {
+			continue
+		}
+		if ageOn(child.BirthDate.Time, asOf) < 18 {
+			child.Address = pt.Address
+		} else {
+			child.Address = []models.Address{GenerateAddress(r, places)}
+		}
+		household = append(household, child)
+	}
+	return household
+}
+
+func newRelatedPerson(r *rand.Rand, relationship, gender string, pt models.Patient, birthDate time.Time) models.RelatedPerson {
+	rp := models.RelatedPerson{Gender: gender}
+	rp.Relationship = roleCode(relationship)
+	rp.Name = &models.HumanName{
+		Given:  []string{fakeSample(r, gender+"_first_names")},
+		Family: pt.Name[0].Family,
+	}
+	rp.BirthDate = &models.FHIRDateTime{Time: birthDate.Truncate(time.Hour * 24), Precision: models.Date}
+	return rp
+}
+
+func roleCode(code string) *models.CodeableConcept {
+	return &models.CodeableConcept{Coding: []models.Coding{{Code: code, System: "http://hl7.org/fhir/v3/RoleCode"}}, Text: relationships[code]}
+}
+
+// ageOn returns the age in whole years on the given date of a person born on
+// the birth date
+func ageOn(birthDate, date time.Time) int {
+	age := date.Year() - birthDate.Year()
+	if date.Month() < birthDate.Month() || (date.Month() == birthDate.Month() && date.Day() < birthDate.Day()) {
+		age--
+	}
+	return age
+}
@@ -0,0 +1,25 @@
+package ptgen
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAgeOnCountsBirthdaysAcrossLeapYears(t *testing.T) {
+	tests := []struct {
+		birthDate, date time.Time
+		age             int
+	}{
+		{day(1952, time.June, 1), day(2017, time.June, 1), 65},
+		{day(1952, time.June, 1), day(2017, time.May, 31), 64},
+		{day(1953, time.June, 1), day(2016, time.June, 1), 63},
+		{day(1953, time.June, 2), day(2016, time.June, 1), 62},
+		{day(1952, time.February, 29), day(2017, time.February, 28), 64},
+		{day(1952, time.February, 29), day(2017, time.March, 1), 65},
+	}
+	for _, test := range tests {
+		if age := ageOn(test.birthDate, test.date); age != test.age {
+			t.Errorf("Expected someone born on %s to be %d on %s, got %d", test.birthDate.Format("2006-01-02"), test.age, test.date.Format("2006-01-02"), age)
+		}
+	}
+}
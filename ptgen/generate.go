@@ -0,0 +1,300 @@
+package ptgen
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// Context contains information about the patient that can be used when
+// generating information
+type Context struct {
+	Smoker       string
+	Hypertention string
+	Alcohol      string
+	Cholesterol  string
+	Diabetes     string
+	Height       int
+	Weight       int
+	BirthDate    time.Time
+	QuitDate     time.Time
+	AsOf         time.Time
+	MetricUnits  bool
+	Trajectories map[string]*Trajectory
+}
+
+// GeneratePatient generates the FHIR resources for a single synthetic patient.
+// All randomness is drawn from r, so patients generated from identically
+// seeded sources are identical.  Patients are drawn from the default profile,
+// are generated as of today, and are not assigned to any providers.
+func GeneratePatient(r *rand.Rand) []interface{} {
+	return GeneratePatientFromProfile(r, DefaultProfile(), DefaultCatalog(), nil, Today())
+}
+
+// GeneratePatientFromProfile generates a patient drawn from the population
+// described by the profile, with conditions and medications from the catalog.
+// If a directory is given, the patient is assigned to its providers (see
+// Directory.Assign).  All of the patient's dates are relative to the as-of
+// date, and none are after it, so patients generated from identically seeded
+// sources with the same as-of date are identical whenever they are generated.
+func GeneratePatientFromProfile(r *rand.Rand, p *Profile, catalog *Catalog, dir *Directory, asOf time.Time) []interface{} {
+	resources, _ := GeneratePatientWithRisks(r, p, catalog, dir, asOf)
+	return resources
+}
+
+// GeneratePatientWithRisks generates a patient exactly as
+// GeneratePatientFromProfile does, and also returns the patient's expected
+// score under each supported risk model (see ScoreRisks), as of the as-of date
+// or the patient's death
+func GeneratePatientWithRisks(r *rand.Rand, p *Profile, catalog *Catalog, dir *Directory, asOf time.Time) ([]interface{}, []RiskScore) {
+	asOf = asOf.Truncate(time.Hour * 24)
+	pt := GenerateDemographics(r, p, asOf)
+	var relatives []Relative
+	var household []models.RelatedPerson
+	householdDiceRoll := r.Intn(100)
+	if householdDiceRoll < p.HouseholdPercent {
+		// Only adults have a spouse and children of their own; children
+		// just get the family history of their parents and siblings
+		if ageOn(pt.BirthDate.Time, asOf) >= 18 {
+			household = GenerateHousehold(r, pt, p.Places(), asOf)
+			pt.MaritalStatus = maritalStatus("Married")
+		}
+		relatives = GenerateParentsAndSiblings(r, pt.BirthDate.Time, asOf)
+		p = p.WithFamilyHistory(relatives)
+	}
+	ctx := NewContext(r, p)
+	ctx.Height, ctx.Weight = initialHeightAndWeight(r, pt.Gender)
+	ctx.BirthDate = pt.BirthDate.Time
+	ctx.AsOf = asOf
+	md := catalog.Conditions
+	mmd := catalog.Medications
+	conditions := GenerateConditions(r, ctx, catalog, p)
+	if ctx.Smoker == "Ex-smoker" {
+		ctx.QuitDate = SmokingQuitDate(r, conditions, asOf)
+	}
+	procedures := make(map[string]*models.Procedure)
+	for i := range conditions {
+		proc := GenerateProcedure(r, &conditions[i], conditionByName(conditions[i].Code.Text, md), asOf)
+		if proc != nil {
+			procedures[conditions[i].Id] = proc
+		}
+	}
+	deathDate := GenerateDeath(r, conditions, md, asOf)
+	pt.DeceasedDateTime = deathDate
+	// Conditions still active when the patient dies never abate
+	for i := range conditions {
+		if a := conditions[i].AbatementDateTime; a != nil && !aliveOn(a.Time, deathDate) {
+			conditions[i].AbatementDateTime = nil
+		}
+	}
+	// Medications are ordered and dispensed before the trajectories are
+	// drawn, so that measurements only respond to them while they are taken
+	orders := make(map[string][]models.MedicationOrder)
+	dispenses := make(map[string][]models.MedicationDispense)
+	coverage := make(map[string][]models.Period)
+	for i := range conditions {
+		c := &conditions[i]
+		if !aliveOn(c.OnsetDateTime.Time, deathDate) {
+			continue
+		}
+		medicationID := conditionByName(c.Code.Text, md).MedicationID
+		orders[c.Id], dispenses[c.Id] = GenerateMedicationOrders(r, medicationID, c.OnsetDateTime, medicationEnd(c, deathDate), p, mmd, asOf)
+		coverage[c.Id] = DispenseCoverage(dispenses[c.Id])
+	}
+	ctx.Trajectories = NewTrajectories(r, ctx, conditions, md, mmd, coverage, deathDate)
+	var m []interface{}
+	m = append(m, &pt)
+	for i := range household {
+		household[i].Patient = &models.Reference{Reference: "cid:" + pt.Id}
+		m = append(m, &household[i])
+	}
+	histories := GenerateFamilyMemberHistories(relatives, md, catalog.CodeSystems, asOf)
+	for i := range histories {
+		histories[i].Patient = &models.Reference{Reference: "cid:" + pt.Id}
+		m = append(m, &histories[i])
+	}
+	imms := GenerateImmunizations(r, pt.BirthDate.Time, deathDate, p, asOf)
+	for i := range imms {
+		imms[i].Patient = &models.Reference{Reference: "cid:" + pt.Id}
+		m = append(m, &imms[i])
+	}
+	allergies := GenerateAllergies(r, pt.BirthDate.Time, deathDate, asOf)
+	for i := range allergies {
+		allergies[i].Patient = &models.Reference{Reference: "cid:" + pt.Id}
+		m = append(m, &allergies[i])
+	}
+	for _, date := range WellnessVisitDates(r, pt.BirthDate.Time, deathDate, asOf) {
+		encounter := GenerateOfficeVisit(r, date, nil)
+		m = appendEncounter(r, m, encounter, GenerateWellnessObservations(r, ctx, date), pt.Id)
+	}
+	for _, visit := range GenerateEmergencyVisits(r, conditions, deathDate, p, asOf) {
+		date := visit.Encounter.Period.Start.Time
+		m = appendEncounter(r, m, visit.Encounter, GenerateBP(r, ctx, date), pt.Id)
+		if visit.Admission != nil {
+			m = appendInpatientStay(r, m, ctx, visit.Admission, visit.Condition, deathDate, p, pt.Id)
+		}
+	}
+
+	for i := range conditions {
+		c := conditions[i]
+		if !aliveOn(c.OnsetDateTime.Time, deathDate) {
+			continue
+		}
+		c.Patient = &models.Reference{Reference: "cid:" + pt.Id}
+		m = append(m, &c)
+		conditionMetadata := conditionByName(c.Code.Text, md)
+		med := GenerateMedication(conditionMetadata.MedicationID, c.OnsetDateTime, medicationEnd(&c, deathDate), mmd)
+		if med != nil {
+			med.Patient = &models.Reference{Reference: "cid:" + pt.Id}
+			m = append(m, med)
+		}
+		for j := range orders[c.Id] {
+			o := &orders[c.Id][j]
+			o.Patient = &models.Reference{Reference: "cid:" + pt.Id}
+			o.ReasonReference = &models.Reference{Reference: "cid:" + c.Id}
+			m = append(m, o)
+		}
+		for j := range dispenses[c.Id] {
+			d := &dispenses[c.Id][j]
+			d.Patient = &models.Reference{Reference: "cid:" + pt.Id}
+			m = append(m, d)
+		}
+
+		procedure := procedures[c.Id]
+		if procedure != nil && aliveOn(procedure.PerformedDateTime.Time, deathDate) {
+			procedure.Subject = &models.Reference{Reference: "cid:" + pt.Id}
+			m = append(m, procedure)
+		}
+
+		stay := GenerateInpatientStay(r, &c, conditionMetadata, deathDate, asOf)
+		if stay != nil {
+			m = appendInpatientStay(r, m, ctx, stay, &c, deathDate, p, pt.Id)
+		}
+
+		for _, date := range FollowUpDates(&c, conditionMetadata, procedure, deathDate, asOf) {
+			encounter := GenerateOfficeVisit(r, date, &c)
+			m = appendEncounter(r, m, encounter, GenerateFollowUpObservations(r, ctx, conditionMetadata, date), pt.Id)
+		}
+	}
+
+	if dir != nil {
+		dir.Assign(r, m)
+	}
+	scored := asOf
+	if deathDate != nil {
+		scored = deathDate.Time
+	}
+	return m, ScoreRisks(ctx, pt.Gender, conditions, scored)
+}
+
+// medicationEnd returns the date a medication treating the condition ends: when
+// the condition abates or the patient dies, whichever comes first.  If the
+// medication is ongoing, nil is returned.
+func medicationEnd(c *models.Condition, deathDate *models.FHIRDateTime) *models.FHIRDateTime {
+	end := c.AbatementDateTime
+	if deathDate != nil && (end == nil || end.Time.After(deathDate.Time)) {
+		end = deathDate
+	}
+	return end
+}
+
+// appendInpatientStay appends the inpatient stay and the observations taken
+// during it, followed by any readmissions after it
+func appendInpatientStay(r *rand.Rand, m []interface{}, ctx Context, stay *models.Encounter, c *models.Condition, deathDate *models.FHIRDateTime, p *Profile, patientID string) []interface{} {
+	for stay != nil {
+		m = appendEncounter(r, m, stay, GenerateInpatientObservations(r, ctx, stay), patientID)
+		stay = GenerateReadmission(r, stay, c, deathDate, p, ctx.AsOf)
+	}
+	return m
+}
+
+// appendEncounter appends the encounter, the observations taken during it, and
+// the reports grouping its lab results to the patient's resources
+func appendEncounter(r *rand.Rand, m []interface{}, encounter *models.Encounter, obs []models.Observation, patientID string) []interface{} {
+	encounter.Patient = &models.Reference{Reference: "cid:" + patientID}
+	m = append(m, encounter)
+	reports := GenerateLabReports(r, obs)
+	for j := range obs {
+		o := obs[j]
+		o.Subject = &models.Reference{Reference: "cid:" + patientID}
+		o.Encounter = &models.Reference{Reference: "cid:" + encounter.Id}
+		m = append(m, &o)
+	}
+	for j := range reports {
+		report := reports[j]
+		report.Subject = &models.Reference{Reference: "cid:" + patientID}
+		report.Encounter = &models.Reference{Reference: "cid:" + encounter.Id}
+		m = append(m, &report)
+	}
+	return m
+}
+
+// GenerateDemographics returns a patient with an ID, gender, name, birth date
+// and address (in the profile's region), along with a medical record number
+// derived from the ID, telecom, preferred language, marital status, and race
+// and ethnicity drawn from the profile.  Patients under 18 are never married.
+func GenerateDemographics(r *rand.Rand, p *Profile, asOf time.Time) models.Patient {
+	patient := models.Patient{}
+	patient.Id = strconv.FormatInt(r.Int63(), 10)
+	patient.Gender = "male"
+	femaleDiceRoll := r.Intn(100)
+	if femaleDiceRoll < p.FemalePercent {
+		patient.Gender = "female"
+	}
+	name := models.HumanName{}
+	name.Given = []string{fakeSample(r, patient.Gender+"_first_names")}
+	name.Family = []string{fakeSample(r, patient.Gender+"_last_names") + randomDigits(r, 4)}
+	patient.Name = []models.HumanName{name}
+	patient.BirthDate = &models.FHIRDateTime{Time: RandomBirthDate(r, p.MinAge, p.MaxAge, asOf), Precision: models.Date}
+	patient.Address = []models.Address{GenerateAddress(r, p.Places())}
+	generateIdentity(r, &patient, p)
+	if ageOn(patient.BirthDate.Time, asOf) < 18 {
+		patient.MaritalStatus = maritalStatus("Never Married")
+	}
+	return patient
+}
+
+// RandomBirthDate generates a random birth date between minAge and maxAge
+// years before the as-of date
+func RandomBirthDate(r *rand.Rand, minAge, maxAge int, asOf time.Time) time.Time {
+	randomYears := r.Intn(maxAge - minAge)
+	yearsAgo := randomYears + minAge
+	randomMonth := r.Intn(11)
+	randomDay := r.Intn(28)
+	return asOf.AddDate(-yearsAgo, -randomMonth, -randomDay).Truncate(time.Hour * 24)
+}
+
+// Today returns the current date, the as-of date patients are generated as of
+// by default
+func Today() time.Time {
+	return time.Now().Truncate(time.Hour * 24)
+}
+
+// NewContext generates a new context with content randomly populated according
+// to the prevalences in the profile
+func NewContext(r *rand.Rand, p *Profile) Context {
+	ctx := Context{}
+	ctx.Smoker = choose(r, p.Smoking)
+	ctx.Alcohol = choose(r, p.Alcohol)
+	ctx.Cholesterol = choose(r, p.Cholesterol)
+	ctx.Hypertention = choose(r, p.Hypertension)
+	ctx.Diabetes = choose(r, p.Diabetes)
+	ctx.MetricUnits = p.MetricUnits
+	return ctx
+}
+
+func initialHeightAndWeight(r *rand.Rand, gender string) (h, w int) {
+	if gender == "male" {
+		h = 60 + r.Intn(20)
+	} else {
+		h = 55 + r.Intn(20)
+	}
+	minBMI := float64(18)
+	englishBMIConstant := float64(703)
+	minWeight := (minBMI / englishBMIConstant) * math.Pow(float64(h), float64(2))
+	w = int(minWeight) + r.Intn(200)
+	return
+}
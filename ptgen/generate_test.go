@@ -0,0 +1,136 @@
+package ptgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// generateJSON generates count patients from the seed, and returns them
+// marshaled to JSON
+func generateJSON(t *testing.T, seed int64, count int) []byte {
+	p := DefaultProfile()
+	p.HouseholdPercent = 50
+	asOf := time.Date(2016, time.June, 1, 0, 0, 0, 0, time.UTC)
+	r := NewRand(seed)
+	dir, err := GenerateDirectory(r, p.Places(), 2, 1, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	if err := enc.Encode(dir.Resources()); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < count; i++ {
+		resources, risks := GeneratePatientWithRisks(r, p, DefaultCatalog(), dir, asOf)
+		if err := enc.Encode(resources); err != nil {
+			t.Fatal(err)
+		}
+		if err := enc.Encode(risks); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return buf.Bytes()
+}
+
+func TestGenerationIsDeterministic(t *testing.T) {
+	first := generateJSON(t, 42, 20)
+	second := generateJSON(t, 42, 20)
+	if !bytes.Equal(first, second) {
+		t.Error("Generating twice from the same seed produced different patients")
+	}
+	if bytes.Equal(first, generateJSON(t, 43, 20)) {
+		t.Error("Generating from different seeds produced the same patients")
+	}
+}
+
+func TestMinorsHaveNoSpouse(t *testing.T) {
+	p := DefaultProfile()
+	p.MinAge, p.MaxAge = 2, 17
+	p.HouseholdPercent = 100
+	asOf := time.Date(2016, time.June, 1, 0, 0, 0, 0, time.UTC)
+	r := NewRand(7)
+	for i := 0; i < 50; i++ {
+		for _, resource := range GeneratePatientFromProfile(r, p, DefaultCatalog(), nil, asOf) {
+			switch resource := resource.(type) {
+			case *models.Patient:
+				if status := resource.MaritalStatus.Text; status != "Never Married" {
+					t.Errorf("Expected a patient under 18 to have never married, got %s", status)
+				}
+			case *models.RelatedPerson:
+				t.Errorf("Expected a patient under 18 to have no household, got %s", resource.Relationship.Text)
+			}
+		}
+	}
+}
+
+func TestMedicalRecordNumbersAreUnique(t *testing.T) {
+	r := NewRand(11)
+	mrns := make(map[string]bool)
+	for i := 0; i < 1000; i++ {
+		pt := GenerateDemographics(r, DefaultProfile(), Today())
+		mrn := pt.Identifier[0].Value
+		if mrns[mrn] {
+			t.Fatalf("Medical record number %s was generated twice", mrn)
+		}
+		mrns[mrn] = true
+	}
+}
+
+// eventDates returns the dates on which the things a resource records happened
+func eventDates(resource interface{}) []*models.FHIRDateTime {
+	switch resource := resource.(type) {
+	case *models.Condition:
+		return []*models.FHIRDateTime{resource.OnsetDateTime, resource.AbatementDateTime}
+	case *models.Procedure:
+		return []*models.FHIRDateTime{resource.PerformedDateTime}
+	case *models.Encounter:
+		return []*models.FHIRDateTime{resource.Period.Start, resource.Period.End}
+	case *models.Observation:
+		return []*models.FHIRDateTime{resource.EffectiveDateTime}
+	case *models.DiagnosticReport:
+		return []*models.FHIRDateTime{resource.EffectiveDateTime, resource.Issued}
+	case *models.MedicationStatement:
+		if resource.EffectivePeriod != nil {
+			return []*models.FHIRDateTime{resource.EffectivePeriod.Start, resource.EffectivePeriod.End}
+		}
+	case *models.MedicationOrder:
+		return []*models.FHIRDateTime{resource.DateWritten, resource.DateEnded}
+	case *models.MedicationDispense:
+		return []*models.FHIRDateTime{resource.WhenPrepared, resource.WhenHandedOver}
+	case *models.Immunization:
+		return []*models.FHIRDateTime{resource.Date}
+	case *models.AllergyIntolerance:
+		return []*models.FHIRDateTime{resource.Onset, resource.RecordedDate, resource.LastOccurence}
+	}
+	return nil
+}
+
+func TestNothingHappensAfterDeath(t *testing.T) {
+	p := DefaultProfile()
+	asOf := time.Date(2016, time.June, 1, 0, 0, 0, 0, time.UTC)
+	r := NewRand(42)
+	deaths := 0
+	for i := 0; i < 300; i++ {
+		resources := GeneratePatientFromProfile(r, p, DefaultCatalog(), nil, asOf)
+		death := resources[0].(*models.Patient).DeceasedDateTime
+		if death == nil {
+			continue
+		}
+		deaths++
+		for _, resource := range resources {
+			for _, date := range eventDates(resource) {
+				if date != nil && date.Time.After(death.Time) {
+					t.Errorf("Expected nothing after the patient's death on %s, got a %T on %s", death.Time.Format("2006-01-02"), resource, date.Time.Format("2006-01-02"))
+				}
+			}
+		}
+	}
+	if deaths == 0 {
+		t.Error("Expected some patients to die")
+	}
+}
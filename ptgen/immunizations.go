@@ -0,0 +1,169 @@
+package ptgen
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+	"github.com/jmcvetta/randutil"
+)
+
+// An ImmunizationSchedule describes how a population is immunized with one of
+// the vaccines: the age from which patients are eligible, the percentage of
+// eligible doses that are given, and the percentage of doses that aren't given
+// for which a refusal is recorded
+type ImmunizationSchedule struct {
+	MinAge          int `json:"minAge"`
+	CoveragePercent int `json:"coveragePercent"`
+	RefusalPercent  int `json:"refusalPercent"`
+}
+
+type vaccine struct {
+	CVX     string
+	Display string
+}
+
+// vaccines contains the CVX code and display of each vaccine, keyed by the
+// names used in profiles
+var vaccines = map[string]vaccine{
+	"influenza": {"141", "Influenza, seasonal, injectable"},
+	"pcv13":     {"133", "Pneumococcal conjugate PCV 13"},
+	"ppsv23":    {"33", "Pneumococcal polysaccharide PPV23"},
+	"zoster":    {"187", "Zoster vaccine recombinant"},
+}
+
+// highDoseInfluenza is the influenza vaccine given to patients 65 and older
+var highDoseInfluenza = vaccine{"135", "Influenza, high dose seasonal"}
+
+// fluSeasons is the number of past influenza seasons immunizations are
+// generated for
+const fluSeasons = 5
+
+// oneTimeYears is the number of past years in which vaccines given once
+// (or in a single series) may have been given
+const oneTimeYears = 10
+
+// notGivenReasons contains the v3 ActReason codes recorded when an
+// immunization isn't given, weighted by how often each is recorded
+var notGivenReasons = []randutil.Choice{
+	{Weight: 7, Item: models.Coding{Code: "PATOBJ", Display: "patient objection"}},
+	{Weight: 1, Item: models.Coding{Code: "MEDPREC", Display: "medical precaution"}},
+	{Weight: 1, Item: models.Coding{Code: "IMMUNE", Display: "immunity"}},
+	{Weight: 1, Item: models.Coding{Code: "OSTOCK", Display: "product out of stock"}},
+}
+
+// GenerateImmunizations returns the patient's immunization history according
+// to the profile's immunization schedules: an influenza vaccine each autumn
+// for the five seasons up to the as-of date (high dose from age 65), a PCV13
+// vaccine followed at least a year later by a PPSV23 vaccine, and a two dose
+// zoster series, each given once the patient is old enough.  Doses that aren't
+// given may be recorded as refused.  No doses are given after the patient's
+// death or the as-of date.
+func GenerateImmunizations(r *rand.Rand, birthDate time.Time, deathDate *models.FHIRDateTime, p *Profile, asOf time.Time) []models.Immunization {
+	var imms []models.Immunization
+	for _, name := range p.sortedImmunizations() {
+		schedule := p.Immunizations[name]
+		eligible := birthDate.AddDate(schedule.MinAge, 0, 0)
+		switch name {
+		case "influenza":
+			year := asOf.Year()
+			if asOf.Month() < time.September {
+				year--
+			}
+			for season := year - fluSeasons + 1; season <= year; season++ {
+				date := time.Date(season, time.September, 1, 0, 0, 0, 0, asOf.Location()).AddDate(0, r.Intn(3), r.Intn(28))
+				v := vaccines[name]
+				if ageOn(birthDate, date) >= 65 {
+					v = highDoseInfluenza
+				}
+				imms = appendImmunization(r, imms, v, date, eligible, deathDate, asOf, schedule)
+			}
+		case "pcv13", "ppsv23", "zoster":
+			date := oneTimeDate(r, eligible, asOf)
+			// PPSV23 is given at least a year after PCV13
+			if name == "ppsv23" {
+				if pcv13 := lastGiven(imms, vaccines["pcv13"].CVX); pcv13 != nil {
+					date = pcv13.AddDate(1, r.Intn(12), r.Intn(28))
+				}
+			}
+			n := len(imms)
+			imms = appendImmunization(r, imms, vaccines[name], date, eligible, deathDate, asOf, schedule)
+			// The zoster series is completed two to six months after the first dose
+			if name == "zoster" && len(imms) > n && !*imms[n].WasNotGiven {
+				imms = appendImmunization(r, imms, vaccines[name], date.AddDate(0, 2+r.Intn(5), 0), eligible, deathDate, asOf, schedule)
+			}
+		}
+	}
+	return imms
+}
+
+// oneTimeDate returns a random date within the years before the as-of date in
+// which a vaccine that is given once may have been given, on or after the date
+// the patient became eligible for it
+func oneTimeDate(r *rand.Rand, eligible, asOf time.Time) time.Time {
+	earliest := asOf.AddDate(-oneTimeYears, 0, 0)
+	if eligible.After(earliest) {
+		earliest = eligible
+	}
+	days := int(asOf.Sub(earliest).Hours() / 24)
+	if days <= 0 {
+		return eligible
+	}
+	return earliest.AddDate(0, 0, r.Intn(days)).Truncate(time.Hour * 24)
+}
+
+// appendImmunization appends the dose on the given date, or a refusal of it,
+// if the patient was eligible and alive on the date and it isn't after the
+// as-of date
+func appendImmunization(r *rand.Rand, imms []models.Immunization, v vaccine, date, eligible time.Time, deathDate *models.FHIRDateTime, asOf time.Time, schedule ImmunizationSchedule) []models.Immunization {
+	if date.Before(eligible) || date.After(asOf) || !aliveOn(date, deathDate) {
+		return imms
+	}
+	notGiven := false
+	coverageDiceRoll := r.Intn(100)
+	if coverageDiceRoll >= schedule.CoveragePercent {
+		refusalDiceRoll := r.Intn(100)
+		if refusalDiceRoll >= schedule.RefusalPercent {
+			return imms
+		}
+		notGiven = true
+	}
+	reported := false
+	imm := models.Immunization{Status: "completed", WasNotGiven: &notGiven, Reported: &reported}
+	imm.Id = strconv.FormatInt(r.Int63(), 10)
+	imm.Date = &models.FHIRDateTime{Time: date, Precision: models.Date}
+	imm.VaccineCode = &models.CodeableConcept{Coding: []models.Coding{{Code: v.CVX, System: "http://hl7.org/fhir/sid/cvx"}}, Text: v.Display}
+	if notGiven {
+		reason := weightedChoice(r, notGivenReasons).Item.(models.Coding)
+		imm.Explanation = &models.ImmunizationExplanationComponent{ReasonNotGiven: []models.CodeableConcept{
+			{Coding: []models.Coding{{Code: reason.Code, System: "http://hl7.org/fhir/v3/ActReason"}}, Text: reason.Display},
+		}}
+	}
+	return append(imms, imm)
+}
+
+// lastGiven returns the date of the last dose of the vaccine that was given,
+// or nil if none was
+func lastGiven(imms []models.Immunization, cvx string) *time.Time {
+	var last *time.Time
+	for i := range imms {
+		imm := imms[i]
+		if imm.VaccineCode.Coding[0].Code == cvx && !*imm.WasNotGiven {
+			last = &imm.Date.Time
+		}
+	}
+	return last
+}
+
+// sortedImmunizations returns the names of the vaccines in the profile's
+// immunization schedules, in sorted order (which puts PCV13 before PPSV23)
+func (p *Profile) sortedImmunizations() []string {
+	names := make([]string, 0, len(p.Immunizations))
+	for name := range p.Immunizations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,217 @@
+package ptgen
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+type MedicationMetadata struct {
+	ID         int    `json:"medication_id"`
+	RxNormCode string `json:"rxNormCode"`
+	BrandName  string `json:"brandName"`
+	TradeName  string `json:"tradeName"`
+	AsNeeded   bool   `json:"asNeeded"`
+	Rate       string `json:"rate"`
+}
+
+func GenerateMedication(medicationID int, onset *models.FHIRDateTime, abatement *models.FHIRDateTime, mmd []MedicationMetadata) *models.MedicationStatement {
+	if medicationID == 0 {
+		return nil
+	} else {
+		ms := &models.MedicationStatement{}
+		mmd := medicationByID(medicationID, mmd)
+		ms.EffectivePeriod = &models.Period{Start: onset}
+		if abatement != nil {
+			ms.EffectivePeriod.End = abatement
+			ms.Status = "completed"
+		} else {
+			ms.Status = "active"
+		}
+		ms.MedicationCodeableConcept = mmd.CodeableConcept()
+		return ms
+	}
+}
+
+// CodeableConcept returns the RxNorm coding of the medication, named by its
+// trade name, or its brand name if it has no trade name
+func (m *MedicationMetadata) CodeableConcept() *models.CodeableConcept {
+	medName := m.TradeName
+	if medName == "N/A" {
+		medName = m.BrandName
+	}
+	return &models.CodeableConcept{Coding: []models.Coding{{Code: m.RxNormCode, System: rxNormSystem}}, Text: medName}
+}
+
+// supplyDays is the number of days of medication supplied by each dispense
+const supplyDays = 30
+
+// GenerateMedicationOrders returns the orders prescribing the medication from
+// the start date until the end date (or the as-of date, if the medication is
+// ongoing), and the dispenses filling them.  Each order is valid for a year,
+// with enough refills to last until it expires, and is renewed by a new order
+// referencing the prior one.  A last order that would expire after the as-of
+// date is valid until the end date if the medication has ended, and is left
+// open otherwise.  Refills follow the adherence in the profile: a refill may
+// be up to two weeks late, the patient may go without the medication for one
+// to three months before refilling it, or the patient may stop filling it
+// altogether before the end date.
+func GenerateMedicationOrders(r *rand.Rand, medicationID int, start, end *models.FHIRDateTime, p *Profile, mmd []MedicationMetadata, asOf time.Time) ([]models.MedicationOrder, []models.MedicationDispense) {
+	m := medicationByID(medicationID, mmd)
+	stop := asOf
+	if end != nil && end.Time.Before(stop) {
+		stop = end.Time
+	}
+	if m == nil || start.Time.After(stop) {
+		return nil, nil
+	}
+
+	var orders []models.MedicationOrder
+	for written := start.Time; !written.After(stop); written = written.AddDate(1, 0, 0) {
+		o := newMedicationOrder(r, m, written, stop, asOf)
+		if len(orders) > 0 {
+			prior := &orders[len(orders)-1]
+			prior.Status = "completed"
+			prior.DateEnded = &models.FHIRDateTime{Time: written, Precision: models.Date}
+			o.PriorPrescription = &models.Reference{Reference: "cid:" + prior.Id}
+		}
+		orders = append(orders, o)
+	}
+	if end != nil && !end.Time.After(asOf) {
+		last := &orders[len(orders)-1]
+		last.Status = "completed"
+		last.DateEnded = end
+		if last.DispenseRequest.ValidityPeriod.End == nil {
+			last.DispenseRequest.ValidityPeriod.End = end
+		}
+	}
+
+	lastFill := stop
+	discontinueDiceRoll := r.Intn(100)
+	if discontinueDiceRoll < p.DiscontinuationPercent {
+		lastFill = start.Time.AddDate(0, 0, r.Intn(int(stop.Sub(start.Time).Hours()/24)+1))
+	}
+	var dispenses []models.MedicationDispense
+	for fill := start.Time; !fill.After(lastFill); {
+		order := &orders[0]
+		for i := range orders {
+			if !orders[i].DateWritten.Time.After(fill) {
+				order = &orders[i]
+			}
+		}
+		dispenses = append(dispenses, newMedicationDispense(r, m, order, fill, len(dispenses) == 0))
+
+		next := fill.AddDate(0, 0, supplyDays)
+		lateDiceRoll := r.Intn(100)
+		if lateDiceRoll < p.LateRefillPercent {
+			next = next.AddDate(0, 0, 1+r.Intn(14))
+		}
+		gapDiceRoll := r.Intn(100)
+		if gapDiceRoll < p.RefillGapPercent {
+			next = next.AddDate(0, 0, 30+r.Intn(61))
+		}
+		fill = next
+	}
+	return orders, dispenses
+}
+
+// newMedicationOrder returns an order written on the given date, valid for a
+// year, with enough refills to supply the medication until it expires or until
+// the stop date.  If the order hasn't expired by the as-of date, its validity
+// period is left open.
+func newMedicationOrder(r *rand.Rand, m *MedicationMetadata, written, stop, asOf time.Time) models.MedicationOrder {
+	expires := written.AddDate(1, 0, 0)
+	supplied := expires
+	if stop.Before(supplied) {
+		supplied = stop
+	}
+	refills := uint32(0)
+	if fills := int(supplied.Sub(written).Hours()/24+supplyDays-1) / supplyDays; fills > 1 {
+		refills = uint32(fills - 1)
+	}
+	days := float64(supplyDays)
+
+	o := models.MedicationOrder{Status: "active"}
+	o.Id = strconv.FormatInt(r.Int63(), 10)
+	o.DateWritten = &models.FHIRDateTime{Time: written, Precision: models.Date}
+	o.MedicationCodeableConcept = m.CodeableConcept()
+	o.DosageInstruction = []models.MedicationOrderDosageInstructionComponent{m.dosageInstruction()}
+	o.DispenseRequest = &models.MedicationOrderDispenseRequestComponent{
+		ValidityPeriod:         &models.Period{Start: &models.FHIRDateTime{Time: written, Precision: models.Date}},
+		NumberOfRepeatsAllowed: &refills,
+		ExpectedSupplyDuration: newQuantity(days, "days"),
+	}
+	if !expires.After(asOf) {
+		o.DispenseRequest.ValidityPeriod.End = &models.FHIRDateTime{Time: expires, Precision: models.Date}
+	}
+	return o
+}
+
+func newMedicationDispense(r *rand.Rand, m *MedicationMetadata, order *models.MedicationOrder, date time.Time, first bool) models.MedicationDispense {
+	days := float64(supplyDays)
+	d := models.MedicationDispense{Status: "completed"}
+	d.Id = strconv.FormatInt(r.Int63(), 10)
+	d.AuthorizingPrescription = []models.Reference{{Reference: "cid:" + order.Id}}
+	d.Type = &models.CodeableConcept{Coding: []models.Coding{{Code: "RF", System: "http://hl7.org/fhir/v3/ActCode"}}, Text: "Refill"}
+	if first {
+		d.Type = &models.CodeableConcept{Coding: []models.Coding{{Code: "FF", System: "http://hl7.org/fhir/v3/ActCode"}}, Text: "First Fill"}
+	}
+	d.DaysSupply = newQuantity(days, "days")
+	d.MedicationCodeableConcept = m.CodeableConcept()
+	d.WhenPrepared = &models.FHIRDateTime{Time: date, Precision: models.Date}
+	d.WhenHandedOver = &models.FHIRDateTime{Time: date, Precision: models.Date}
+	return d
+}
+
+// ratePeriods maps the periods used in medication rates to UCUM units of time
+var ratePeriods = map[string]string{"day": "d", "week": "wk", "month": "mo"}
+
+// dosageInstruction returns the dosage instruction for the medication's rate,
+// such as "10 mg / day".  If the rate has no dose, only its period is used.
+func (m *MedicationMetadata) dosageInstruction() models.MedicationOrderDosageInstructionComponent {
+	di := models.MedicationOrderDosageInstructionComponent{Text: strings.TrimSpace(m.Rate)}
+	if m.AsNeeded {
+		asNeeded := true
+		di.AsNeededBoolean = &asNeeded
+	}
+	parts := strings.Split(m.Rate, "/")
+	if len(parts) != 2 {
+		return di
+	}
+	if units, ok := ratePeriods[strings.TrimSpace(parts[1])]; ok {
+		frequency, period := int32(1), float64(1)
+		di.Timing = &models.Timing{Repeat: &models.TimingRepeatComponent{Frequency: &frequency, Period: &period, PeriodUnits: units}}
+	}
+	dose := strings.Fields(parts[0])
+	if len(dose) == 2 {
+		if value, err := strconv.ParseFloat(dose[0], 64); err == nil {
+			di.DoseSimpleQuantity = newQuantity(value, dose[1])
+		}
+	}
+	return di
+}
+
+// LoadMedications returns the medication metadata compiled into ptgen
+func LoadMedications() []MedicationMetadata {
+	j, err := Asset("data/medications.json")
+	if err != nil {
+		panic("Can't get the medications data")
+	}
+	mmd, err := decodeMedications(j)
+	if err != nil {
+		panic("Can't parse the medications data: " + err.Error())
+	}
+	return mmd
+}
+
+func medicationByID(id int, md []MedicationMetadata) *MedicationMetadata {
+	for _, m := range md {
+		if m.ID == id {
+			return &m
+		}
+	}
+	return nil
+}
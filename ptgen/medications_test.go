@@ -0,0 +1,37 @@
+package ptgen
+
+import (
+	"testing"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+func TestOrdersActiveAtTheAsOfDateHaveOpenValidityPeriods(t *testing.T) {
+	mmd := LoadMedications()
+	start := &models.FHIRDateTime{Time: day(2014, time.March, 1), Precision: models.Date}
+	asOf := day(2016, time.January, 1)
+	orders, _ := GenerateMedicationOrders(NewRand(42), mmd[0].ID, start, nil, DefaultProfile(), mmd, asOf)
+	if len(orders) != 2 {
+		t.Fatalf("Expected two yearly orders, got %d", len(orders))
+	}
+	if end := orders[0].DispenseRequest.ValidityPeriod.End; end == nil || !end.Time.Equal(day(2015, time.March, 1)) {
+		t.Errorf("Expected the first order to expire after a year, got %v", end)
+	}
+	if end := orders[1].DispenseRequest.ValidityPeriod.End; end != nil {
+		t.Errorf("Expected the order still active at the as-of date to have no end, got %s", end.Time)
+	}
+}
+
+func TestEndedOrdersAreValidUntilTheMedicationEnds(t *testing.T) {
+	mmd := LoadMedications()
+	start := &models.FHIRDateTime{Time: day(2015, time.March, 1), Precision: models.Date}
+	end := &models.FHIRDateTime{Time: day(2015, time.September, 1), Precision: models.Date}
+	orders, _ := GenerateMedicationOrders(NewRand(42), mmd[0].ID, start, end, DefaultProfile(), mmd, day(2016, time.January, 1))
+	if len(orders) != 1 {
+		t.Fatalf("Expected one order, got %d", len(orders))
+	}
+	if validity := orders[0].DispenseRequest.ValidityPeriod.End; validity == nil || !validity.Time.Equal(end.Time) {
+		t.Errorf("Expected the order to be valid until the medication ended on %s, got %v", end.Time, validity)
+	}
+}
@@ -0,0 +1,72 @@
+package ptgen
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// GenerateDeath rolls against the mortality chance of each of the patient's
+// conditions and returns the date of death, or nil if the patient is still
+// alive.  The condition the patient dies of remains active.  Patients who
+// survive a condition that is either healed or fatal recover from it.  No
+// patient dies after the as-of date.
+func GenerateDeath(r *rand.Rand, conditions []models.Condition, md []ConditionMetadata, asOf time.Time) *models.FHIRDateTime {
+	var deathDate *models.FHIRDateTime
+	cause := -1
+	for i := range conditions {
+		c := &conditions[i]
+		cmd := conditionByName(c.Code.Text, md)
+		if cmd == nil || cmd.MortalityChance == 0 {
+			continue
+		}
+		window := mortalityWindow(cmd.MortalityTime)
+		mortalityDiceRoll := r.Intn(100)
+		if window > 0 && mortalityDiceRoll < cmd.MortalityChance {
+			t := c.OnsetDateTime.Time.AddDate(0, 0, 1+r.Intn(window))
+			recovered := c.AbatementDateTime != nil && c.AbatementDateTime.Time.Before(t)
+			if !recovered && !t.After(asOf) {
+				if deathDate == nil || t.Before(deathDate.Time) {
+					deathDate = &models.FHIRDateTime{Time: t, Precision: models.Date}
+					cause = i
+				}
+				continue
+			}
+		}
+		if cmd.Fatal && c.AbatementDateTime == nil {
+			c.AbatementDateTime = occurredBy(recoveryDate(c.OnsetDateTime.Time, cmd.RecoveryEstimate), asOf)
+		}
+	}
+
+	if cause >= 0 {
+		conditions[cause].AbatementDateTime = nil
+	}
+	return deathDate
+}
+
+// mortalityWindow returns the number of days within which a patient may die
+// of a condition with the given mortality time
+func mortalityWindow(mortalityTime string) int {
+	switch mortalityTime {
+	case "day":
+		return 1
+	case "threeWeeks":
+		return 21
+	case "twoYears", "twoyears":
+		return 2 * 365
+	case "threeYears":
+		return 3 * 365
+	case "fourYears":
+		return 4 * 365
+	case "sevenYears":
+		return 7 * 365
+	}
+	return 0
+}
+
+// aliveOn indicates whether a patient with the given date of death (or nil if
+// the patient is alive) was still alive on the given date
+func aliveOn(t time.Time, deathDate *models.FHIRDateTime) bool {
+	return deathDate == nil || !t.After(deathDate.Time)
+}
@@ -0,0 +1,151 @@
+package ptgen
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// band is the range of values for a measurement when the patient is in a
+// given state (e.g., "Pre-hypertension")
+type band struct {
+	Min int
+	Max int
+}
+
+func (b band) draw(r *rand.Rand) float64 {
+	return float64(b.Min + r.Intn(b.Max-b.Min))
+}
+
+// bands contains the ranges of values for each state, keyed by LOINC code.
+// Hemoglobin A1c is in tenths of a percent.
+var bands = map[string]map[string]band{
+	"8480-6": {"Normal": {100, 120}, "Pre-hypertension": {120, 140}, "Hypertension": {140, 180}},
+	"8462-4": {"Normal": {65, 80}, "Pre-hypertension": {80, 90}, "Hypertension": {90, 120}},
+	"1558-6": {"Normal": {75, 100}, "Pre-diabetes": {100, 125}, "Diabetes": {200, 300}},
+	"4548-4": {"Normal": {40, 56}, "Pre-diabetes": {57, 64}, "Diabetes": {65, 80}},
+	"13457-7": {"Optimal": {80, 100}, "Near Optimal": {100, 130}, "Borderline": {130, 150},
+		"High": {160, 200}, "Very High": {190, 220}},
+	"2085-9": {"Optimal": {60, 70}, "Near Optimal": {50, 60}, "Borderline": {40, 60},
+		"High": {40, 50}, "Very High": {30, 40}},
+	"3043-7": {"Optimal": {100, 140}, "Near Optimal": {140, 160}, "Borderline": {160, 200},
+		"High": {200, 300}, "Very High": {300, 400}},
+}
+
+// state returns the patient's state for the measurement with the given LOINC
+// code
+func (ctx Context) state(code string) string {
+	switch code {
+	case "8480-6", "8462-4":
+		return ctx.Hypertention
+	case "1558-6", "4548-4":
+		return ctx.Diabetes
+	case "13457-7", "2085-9", "3043-7":
+		return ctx.Cholesterol
+	}
+	return ""
+}
+
+// measure returns the value of the measurement with the given LOINC code on
+// the given date.  If the context has a trajectory for the measurement, the
+// value follows it.  Otherwise the value is drawn from the band for the
+// patient's state.
+func (ctx Context) measure(r *rand.Rand, code string, date time.Time) *models.Quantity {
+	var v float64
+	if t, ok := ctx.Trajectories[code]; ok {
+		v = math.Max(math.Floor(t.ValueAt(r, date)+0.5), 1)
+	} else {
+		v = bands[code][ctx.state(code)].draw(r)
+	}
+	return &models.Quantity{Value: &v}
+}
+
+func GenerateBP(r *rand.Rand, ctx Context, date time.Time) []models.Observation {
+	sys, dia := newObservation(date), newObservation(date)
+	sys.Code = &models.CodeableConcept{Coding: []models.Coding{{Code: "8480-6", System: "http://loinc.org"}}, Text: "Systolic Blood Pressure"}
+	dia.Code = &models.CodeableConcept{Coding: []models.Coding{{Code: "8462-4", System: "http://loinc.org"}}, Text: "Diastolic Blood Pressure"}
+	sys.ValueQuantity = ctx.measure(r, "8480-6", date)
+	dia.ValueQuantity = ctx.measure(r, "8462-4", date)
+	setUnit(sys.ValueQuantity, "mmHg")
+	setUnit(dia.ValueQuantity, "mmHg")
+
+	obs := []models.Observation{sys, dia}
+	interpret(obs)
+	return obs
+}
+
+func GenerateCholesterol(r *rand.Rand, ctx Context, date time.Time) []models.Observation {
+	ldl, hdl, tri := newObservation(date), newObservation(date), newObservation(date)
+	ldl.Code = &models.CodeableConcept{Coding: []models.Coding{{Code: "13457-7", System: "http://loinc.org"}}, Text: "Plasma LDL Cholesterol Measurement"}
+	hdl.Code = &models.CodeableConcept{Coding: []models.Coding{{Code: "2085-9", System: "http://loinc.org"}}, Text: "Plasma HDL Cholesterol Measurement"}
+	tri.Code = &models.CodeableConcept{Coding: []models.Coding{{Code: "3043-7", System: "http://loinc.org"}}, Text: "Plasma Triglyceride Measurement"}
+	ldl.ValueQuantity = ctx.measure(r, "13457-7", date)
+	hdl.ValueQuantity = ctx.measure(r, "2085-9", date)
+	tri.ValueQuantity = ctx.measure(r, "3043-7", date)
+
+	setUnit(ldl.ValueQuantity, "mg/dL")
+	setUnit(hdl.ValueQuantity, "mg/dL")
+	setUnit(tri.ValueQuantity, "mg/dL")
+
+	obs := []models.Observation{ldl, hdl, tri}
+	interpret(obs)
+	if ctx.MetricUnits {
+		observationsToMetric(obs)
+	}
+	return obs
+}
+
+func GenerateWeightAndHeight(r *rand.Rand, ctx Context, date time.Time) []models.Observation {
+	w, h := newObservation(date), newObservation(date)
+	w.Code = &models.CodeableConcept{Coding: []models.Coding{{Code: "29463-7", System: "http://loinc.org"}}, Text: "Body Weight"}
+	h.Code = &models.CodeableConcept{Coding: []models.Coding{{Code: "8302-2", System: "http://loinc.org"}}, Text: "Body Height"}
+
+	if t, ok := ctx.Trajectories["29463-7"]; ok {
+		weight := math.Floor(t.ValueAt(r, date) + 0.5)
+		w.ValueQuantity = &models.Quantity{Value: &weight}
+	} else {
+		w.ValueQuantity = GenerateQuantity(r, ctx.Weight-10, ctx.Weight+10)
+	}
+	height := float64(ctx.Height)
+	h.ValueQuantity = &models.Quantity{Value: &height}
+
+	setUnit(w.ValueQuantity, "lbs")
+	setUnit(h.ValueQuantity, "in")
+
+	obs := []models.Observation{w, h}
+	if ctx.MetricUnits {
+		observationsToMetric(obs)
+	}
+	return obs
+}
+
+func GenerateBloodSugars(r *rand.Rand, ctx Context, date time.Time) []models.Observation {
+	gluc, ha1c := newObservation(date), newObservation(date)
+	gluc.Code = &models.CodeableConcept{Coding: []models.Coding{{Code: "1558-6", System: "http://loinc.org"}}, Text: "Fasting Glucose"}
+	ha1c.Code = &models.CodeableConcept{Coding: []models.Coding{{Code: "4548-4", System: "http://loinc.org"}}, Text: "Hemoglobin A1c"}
+	gluc.ValueQuantity = ctx.measure(r, "1558-6", date)
+	ha1c.ValueQuantity = ctx.measure(r, "4548-4", date)
+
+	setUnit(gluc.ValueQuantity, "mg/dL")
+	percentageValue := *ha1c.ValueQuantity.Value / float64(10)
+	ha1c.ValueQuantity.Value = &percentageValue
+	setUnit(ha1c.ValueQuantity, "%")
+
+	obs := []models.Observation{gluc, ha1c}
+	interpret(obs)
+	if ctx.MetricUnits {
+		observationsToMetric(obs)
+	}
+	return obs
+}
+
+func GenerateQuantity(r *rand.Rand, min, max int) *models.Quantity {
+	q := float64(min + r.Intn(max-min))
+	return &models.Quantity{Value: &q}
+}
+
+func newObservation(date time.Time) models.Observation {
+	return models.Observation{Status: "final", EffectiveDateTime: &models.FHIRDateTime{Time: date, Precision: models.Date}}
+}
@@ -0,0 +1,113 @@
+package ptgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/intervention-engine/fhir/models"
+	"github.com/jmcvetta/randutil"
+)
+
+// A Place is a real city, with its state, county, zip codes and population,
+// and the latitude and longitude of the city
+type Place struct {
+	City        string   `json:"city"`
+	State       string   `json:"state"`
+	County      string   `json:"county"`
+	Population  int      `json:"population"`
+	PostalCodes []string `json:"postalCodes"`
+	Latitude    float64  `json:"latitude"`
+	Longitude   float64  `json:"longitude"`
+}
+
+// censusRegions contains the states (and DC) in each of the Census regions
+var censusRegions = map[string][]string{
+	"Northeast": {"CT", "ME", "MA", "NH", "RI", "VT", "NJ", "NY", "PA"},
+	"Midwest":   {"IL", "IN", "MI", "OH", "WI", "IA", "KS", "MN", "MO", "NE", "ND", "SD"},
+	"South":     {"DE", "DC", "FL", "GA", "MD", "NC", "SC", "VA", "WV", "AL", "KY", "MS", "TN", "AR", "LA", "OK", "TX"},
+	"West":      {"AZ", "CO", "ID", "MT", "NV", "NM", "UT", "WY", "AK", "CA", "HI", "OR", "WA"},
+}
+
+// allPlaces are the places compiled into ptgen
+var allPlaces = LoadPlaces()
+
+// LoadPlaces returns the places compiled into ptgen
+func LoadPlaces() []Place {
+	j, err := Asset("data/places.json")
+	if err != nil {
+		panic("Can't get the place data")
+	}
+	var p []Place
+	if err := json.Unmarshal(j, &p); err != nil {
+		panic("Can't parse the place data: " + err.Error())
+	}
+	return p
+}
+
+// PlacesIn returns the places compiled into ptgen within the region, given as
+// state abbreviations (e.g., "MA") and Census regions (Northeast, Midwest,
+// South or West).  An empty region returns every place.
+func PlacesIn(region []string) ([]Place, error) {
+	if len(region) == 0 {
+		return allPlaces, nil
+	}
+	states := make(map[string]bool)
+	for _, name := range region {
+		if regionStates, ok := censusRegions[name]; ok {
+			for _, state := range regionStates {
+				states[state] = true
+			}
+			continue
+		}
+		known := false
+		for _, pl := range allPlaces {
+			known = known || pl.State == name
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown state or region %q", name)
+		}
+		states[name] = true
+	}
+	var in []Place
+	for _, pl := range allPlaces {
+		if states[pl.State] {
+			in = append(in, pl)
+		}
+	}
+	return in, nil
+}
+
+// GenerateAddress returns a random street address in one of the places, which
+// are drawn in proportion to their populations
+func GenerateAddress(r *rand.Rand, places []Place) models.Address {
+	return choosePlace(r, places).address(r)
+}
+
+// choosePlace selects one of the places, weighted by population
+func choosePlace(r *rand.Rand, places []Place) Place {
+	choices := make([]randutil.Choice, len(places))
+	for i, pl := range places {
+		choices[i] = randutil.Choice{Weight: pl.Population, Item: pl}
+	}
+	return weightedChoice(r, choices).Item.(Place)
+}
+
+// address returns a random street address in one of the place's zip codes,
+// with the place's county as the address's district
+func (pl Place) address(r *rand.Rand) models.Address {
+	street := fakeSample(r, "streets") + " " + fakeSample(r, "street_suffixes")
+	return models.Address{
+		Line:       []string{randomDigits(r, 1+r.Intn(5)) + " " + street},
+		City:       pl.City,
+		District:   pl.County,
+		State:      pl.State,
+		PostalCode: choiceString(r, pl.PostalCodes),
+	}
+}
+
+// position returns the place's latitude and longitude as a location's position
+func (pl Place) position() *models.LocationPositionComponent {
+	latitude, longitude := pl.Latitude, pl.Longitude
+	return &models.LocationPositionComponent{Latitude: &latitude, Longitude: &longitude}
+}
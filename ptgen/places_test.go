@@ -0,0 +1,48 @@
+package ptgen
+
+import "testing"
+
+func TestPlacesHavePopulationsAndZipCodes(t *testing.T) {
+	zips := make(map[string]bool)
+	for _, pl := range allPlaces {
+		if pl.Population <= 0 {
+			t.Errorf("Expected %s, %s to have a population", pl.City, pl.State)
+		}
+		if len(pl.PostalCodes) == 0 {
+			t.Errorf("Expected %s, %s to have zip codes", pl.City, pl.State)
+		}
+		for _, zip := range pl.PostalCodes {
+			if zips[zip] {
+				t.Errorf("Expected zip code %s to be in only one place", zip)
+			}
+			zips[zip] = true
+		}
+	}
+}
+
+func TestAddressesUseTheirPlacesZipCodes(t *testing.T) {
+	places, err := PlacesIn([]string{"MA"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewRand(42)
+	for i := 0; i < 100; i++ {
+		address := GenerateAddress(r, places)
+		var pl *Place
+		for j := range places {
+			if places[j].City == address.City {
+				pl = &places[j]
+			}
+		}
+		if pl == nil {
+			t.Fatalf("Expected an address in Massachusetts, got %s, %s", address.City, address.State)
+		}
+		found := false
+		for _, zip := range pl.PostalCodes {
+			found = found || zip == address.PostalCode
+		}
+		if !found {
+			t.Errorf("Expected a zip code in %s, got %s", pl.City, address.PostalCode)
+		}
+	}
+}
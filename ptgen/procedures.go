@@ -0,0 +1,58 @@
+package ptgen
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// cptSystem is the code system procedures are coded in when the catalog
+// doesn't name one
+const cptSystem = "http://www.ama-assn.org/go/cpt"
+
+// GenerateProcedure rolls against the procedure chance of the condition and
+// returns the procedure performed to treat it, or nil if no procedure was
+// performed.  If the procedure is successful, the condition abates when the
+// patient recovers, unless the recovery ends after the as-of date or the
+// condition has already abated by then.
+func GenerateProcedure(r *rand.Rand, c *models.Condition, cmd *ConditionMetadata, asOf time.Time) *models.Procedure {
+	if cmd == nil || cmd.ProcedureChance == 0 || cmd.ProcedureCode == "" || cmd.ProcedureCode == "00000" {
+		return nil
+	}
+	procedureDiceRoll := r.Intn(100)
+	if procedureDiceRoll >= cmd.ProcedureChance {
+		return nil
+	}
+	performed := c.OnsetDateTime.Time.AddDate(0, 0, r.Intn(14))
+	if performed.After(asOf) || (c.AbatementDateTime != nil && performed.After(c.AbatementDateTime.Time)) {
+		return nil
+	}
+
+	system := cmd.ProcedureCodeSystem
+	if system == "" {
+		system = cptSystem
+	}
+	p := &models.Procedure{Status: "completed"}
+	p.Id = strconv.FormatInt(r.Int63(), 10)
+	p.Code = &models.CodeableConcept{Coding: []models.Coding{{Code: cmd.ProcedureCode, System: system}}, Text: cmd.ProcedureName}
+	p.PerformedDateTime = &models.FHIRDateTime{Time: performed, Precision: models.Date}
+	p.ReasonReference = &models.Reference{Reference: "cid:" + c.Id}
+
+	successDiceRoll := r.Intn(100)
+	if successDiceRoll < cmd.ProcedureSuccess {
+		p.Outcome = &models.CodeableConcept{Coding: []models.Coding{{Code: "385669000", System: "http://snomed.info/sct"}}, Text: "Successful"}
+		abatement := recoveryDate(performed, cmd.RecoveryEstimate)
+		if abatement == nil {
+			abatement = p.PerformedDateTime
+		}
+		abatement = occurredBy(abatement, asOf)
+		if abatement != nil && (c.AbatementDateTime == nil || abatement.Time.Before(c.AbatementDateTime.Time)) {
+			c.AbatementDateTime = abatement
+		}
+	} else {
+		p.Outcome = &models.CodeableConcept{Coding: []models.Coding{{Code: "385671000", System: "http://snomed.info/sct"}}, Text: "Unsuccessful"}
+	}
+	return p
+}
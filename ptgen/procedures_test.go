@@ -0,0 +1,54 @@
+package ptgen
+
+import (
+	"testing"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+func successfulProcedure(system string) *ConditionMetadata {
+	return &ConditionMetadata{ProcedureChance: 100, ProcedureSuccess: 100, ProcedureCode: "44950", ProcedureCodeSystem: system, ProcedureName: "Appendectomy", RecoveryEstimate: "sixMonths"}
+}
+
+func TestProceduresAreCodedFromTheCatalog(t *testing.T) {
+	asOf := day(2016, time.January, 1)
+	p := GenerateProcedure(NewRand(42), condition(day(2014, time.January, 1)), successfulProcedure("http://example.org/procedures"), asOf)
+	if p == nil {
+		t.Fatal("Expected a procedure")
+	}
+	if p.Id == "" {
+		t.Error("Expected the procedure to have an ID")
+	}
+	if system := p.Code.Coding[0].System; system != "http://example.org/procedures" {
+		t.Errorf("Expected the procedure to be coded in the catalog's system, got %s", system)
+	}
+	p = GenerateProcedure(NewRand(42), condition(day(2014, time.January, 1)), successfulProcedure(""), asOf)
+	if system := p.Code.Coding[0].System; system != cptSystem {
+		t.Errorf("Expected the procedure to be coded in CPT by default, got %s", system)
+	}
+}
+
+func TestSuccessfulProceduresAbateConditions(t *testing.T) {
+	asOf := day(2016, time.January, 1)
+	c := condition(day(2014, time.January, 1))
+	p := GenerateProcedure(NewRand(42), c, successfulProcedure(""), asOf)
+	if expected := p.PerformedDateTime.Time.AddDate(0, 6, 0); c.AbatementDateTime == nil || !c.AbatementDateTime.Time.Equal(expected) {
+		t.Errorf("Expected the condition to abate on %s, got %v", expected, c.AbatementDateTime)
+	}
+
+	earlier := day(2014, time.February, 1)
+	c = condition(day(2014, time.January, 1))
+	c.AbatementDateTime = &models.FHIRDateTime{Time: earlier, Precision: models.Date}
+	GenerateProcedure(NewRand(42), c, successfulProcedure(""), asOf)
+	if !c.AbatementDateTime.Time.Equal(earlier) {
+		t.Errorf("Expected the condition to keep abating on %s, got %s", earlier, c.AbatementDateTime.Time)
+	}
+
+	c = condition(day(2014, time.January, 1))
+	c.AbatementDateTime = &models.FHIRDateTime{Time: earlier, Precision: models.Date}
+	GenerateProcedure(NewRand(42), c, successfulProcedure(""), day(2014, time.March, 1))
+	if c.AbatementDateTime == nil || !c.AbatementDateTime.Time.Equal(earlier) {
+		t.Errorf("Expected a recovery after the as-of date to keep the abatement on %s, got %v", earlier, c.AbatementDateTime)
+	}
+}
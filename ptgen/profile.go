@@ -0,0 +1,315 @@
+package ptgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+
+	"github.com/jmcvetta/randutil"
+)
+
+// A Profile describes the population that patients are generated from: the
+// range of their ages, the proportion of women, the prevalence of each risk
+// factor state, the incidence of conditions, the proportion of patients
+// generated with a household and family history, how well patients adhere to
+// their medications, how they are immunized, how often they visit the
+// emergency department and are readmitted, and their demographics.  Prevalences
+// and demographic distributions are relative weights keyed by state (e.g.,
+// "Smoker" or "Pre-diabetes"), and incidences and adherence are percentages.
+type Profile struct {
+	Name               string         `json:"name"`
+	MinAge             int            `json:"minAge"`
+	MaxAge             int            `json:"maxAge"`
+	FemalePercent      int            `json:"femalePercent"`
+	Smoking            map[string]int `json:"smoking"`
+	Alcohol            map[string]int `json:"alcohol"`
+	Cholesterol        map[string]int `json:"cholesterol"`
+	Hypertension       map[string]int `json:"hypertension"`
+	Diabetes           map[string]int `json:"diabetes"`
+	AfibPercentUnder65 int            `json:"afibPercentUnder65"`
+	AfibPercentOver65  int            `json:"afibPercentOver65"`
+	MinOtherConditions int            `json:"minOtherConditions"`
+	MaxOtherConditions int            `json:"maxOtherConditions"`
+	ConditionIncidence map[string]int `json:"conditionIncidence"`
+	HouseholdPercent   int            `json:"householdPercent"`
+
+	// Adherence: the percentage of refills that are late, the percentage of
+	// refills that follow a gap without the medication, and the percentage of
+	// medications that are discontinued early
+	LateRefillPercent      int `json:"lateRefillPercent"`
+	RefillGapPercent       int `json:"refillGapPercent"`
+	DiscontinuationPercent int `json:"discontinuationPercent"`
+
+	// Immunizations are keyed by vaccine: influenza, pcv13, ppsv23 or zoster
+	Immunizations map[string]ImmunizationSchedule `json:"immunizations"`
+
+	// Acute utilization: the yearly percentage chance of an emergency visit,
+	// the amount each active condition adds to it, the percentage of
+	// emergency visits that lead to an admission, and the percentage of
+	// discharges followed by a readmission within 30 days
+	EmergencyVisitPercent             int `json:"emergencyVisitPercent"`
+	EmergencyVisitPercentPerCondition int `json:"emergencyVisitPercentPerCondition"`
+	EmergencyAdmissionPercent         int `json:"emergencyAdmissionPercent"`
+	ReadmissionPercent                int `json:"readmissionPercent"`
+
+	// Demographics: the distributions of race, ethnicity, preferred language
+	// and marital status (adults with a household are always married, and
+	// patients under 18 never are), and the percentage of patients with a
+	// mobile phone and with an email address
+	Race               map[string]int `json:"race"`
+	Ethnicity          map[string]int `json:"ethnicity"`
+	Language           map[string]int `json:"language"`
+	MaritalStatus      map[string]int `json:"maritalStatus"`
+	MobilePhonePercent int            `json:"mobilePhonePercent"`
+	EmailPercent       int            `json:"emailPercent"`
+
+	// MetricUnits records weights and heights in kilograms and centimeters,
+	// and blood sugars and lipids in mmol/L, instead of pounds, inches and
+	// mg/dL
+	MetricUnits bool `json:"metricUnits"`
+
+	// Region restricts patients' addresses to the given states and Census
+	// regions (see PlacesIn); if it is empty, addresses are drawn from the
+	// whole country
+	Region []string `json:"region"`
+}
+
+// DefaultProfile returns the geriatric population that Intervention Engine
+// targets: patients between 65 and 85 years old with up to two conditions
+// beyond those caused by their risk factors, half of whom have a household and
+// family history.
+func DefaultProfile() *Profile {
+	return &Profile{
+		Name:               "geriatric",
+		MinAge:             65,
+		MaxAge:             85,
+		FemalePercent:      50,
+		Smoking:            map[string]int{"Smoker": 2, "Non-smoker": 3, "Ex-smoker": 1},
+		Alcohol:            map[string]int{"Occasional": 2, "Heavy": 1, "None": 1},
+		Cholesterol:        map[string]int{"Optimal": 3, "Near Optimal": 1, "Borderline": 2, "High": 1, "Very High": 2},
+		Hypertension:       map[string]int{"Normal": 1, "Pre-hypertension": 1, "Hypertension": 1},
+		Diabetes:           map[string]int{"Normal": 1, "Pre-diabetes": 1, "Diabetes": 1},
+		AfibPercentUnder65: 2,
+		AfibPercentOver65:  9,
+		MinOtherConditions: 0,
+		MaxOtherConditions: 2,
+		ConditionIncidence: map[string]int{},
+		HouseholdPercent:   50,
+
+		LateRefillPercent:      20,
+		RefillGapPercent:       5,
+		DiscontinuationPercent: 10,
+
+		Immunizations: map[string]ImmunizationSchedule{
+			"influenza": {MinAge: 18, CoveragePercent: 70, RefusalPercent: 30},
+			"pcv13":     {MinAge: 65, CoveragePercent: 60, RefusalPercent: 20},
+			"ppsv23":    {MinAge: 65, CoveragePercent: 65, RefusalPercent: 20},
+			"zoster":    {MinAge: 50, CoveragePercent: 35, RefusalPercent: 25},
+		},
+
+		EmergencyVisitPercent:             20,
+		EmergencyVisitPercentPerCondition: 15,
+		EmergencyAdmissionPercent:         30,
+		ReadmissionPercent:                18,
+
+		Race:               map[string]int{"White": 78, "Black or African American": 9, "Asian": 5, "American Indian or Alaska Native": 1, "Native Hawaiian or Other Pacific Islander": 1, "Other Race": 6},
+		Ethnicity:          map[string]int{"Hispanic or Latino": 8, "Not Hispanic or Latino": 92},
+		Language:           map[string]int{"English": 85, "Spanish": 8, "Chinese": 1, "Tagalog": 1, "Vietnamese": 1, "French": 1, "Korean": 1, "German": 1, "Russian": 1, "Arabic": 1},
+		MaritalStatus:      map[string]int{"Married": 55, "Widowed": 25, "Divorced": 13, "Legally Separated": 2, "Never Married": 5},
+		MobilePhonePercent: 70,
+		EmailPercent:       40,
+	}
+}
+
+// LoadProfile reads a JSON profile (YAML isn't supported) from the file at
+// the given path and validates it against the condition metadata.  Any
+// setting missing from the file, including the schedule of any vaccine, keeps
+// its value from the default profile.
+func LoadProfile(path string, md []ConditionMetadata) (*Profile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	defaults := DefaultProfile()
+	p := *defaults
+	p.Smoking, p.Alcohol, p.Cholesterol, p.Hypertension, p.Diabetes, p.ConditionIncidence = nil, nil, nil, nil, nil, nil
+	p.Immunizations = nil
+	p.Race, p.Ethnicity, p.Language, p.MaritalStatus = nil, nil, nil, nil
+	if err := json.NewDecoder(f).Decode(&p); err != nil {
+		return nil, fmt.Errorf("Couldn't parse profile %s: %s", path, err)
+	}
+	if p.Smoking == nil {
+		p.Smoking = defaults.Smoking
+	}
+	if p.Alcohol == nil {
+		p.Alcohol = defaults.Alcohol
+	}
+	if p.Cholesterol == nil {
+		p.Cholesterol = defaults.Cholesterol
+	}
+	if p.Hypertension == nil {
+		p.Hypertension = defaults.Hypertension
+	}
+	if p.Diabetes == nil {
+		p.Diabetes = defaults.Diabetes
+	}
+	if p.ConditionIncidence == nil {
+		p.ConditionIncidence = defaults.ConditionIncidence
+	}
+	if p.Race == nil {
+		p.Race = defaults.Race
+	}
+	if p.Ethnicity == nil {
+		p.Ethnicity = defaults.Ethnicity
+	}
+	if p.Language == nil {
+		p.Language = defaults.Language
+	}
+	if p.MaritalStatus == nil {
+		p.MaritalStatus = defaults.MaritalStatus
+	}
+	if p.Immunizations == nil {
+		p.Immunizations = make(map[string]ImmunizationSchedule)
+	}
+	for name, schedule := range defaults.Immunizations {
+		if _, ok := p.Immunizations[name]; !ok {
+			p.Immunizations[name] = schedule
+		}
+	}
+	if err := p.Validate(md); err != nil {
+		return nil, fmt.Errorf("Invalid profile %s: %s", path, err)
+	}
+	return &p, nil
+}
+
+// Validate checks that the profile's ages and percentages are in range, that
+// its prevalences and demographic distributions only contain known states,
+// that its region only contains known states and Census regions, that its
+// incidences only contain conditions in the condition metadata, and that its
+// immunization schedules are for known vaccines.
+func (p *Profile) Validate(md []ConditionMetadata) error {
+	if p.MinAge < 0 || p.MaxAge <= p.MinAge {
+		return fmt.Errorf("age range %d-%d must be non-negative and increasing", p.MinAge, p.MaxAge)
+	}
+	if p.MinOtherConditions < 0 || p.MaxOtherConditions < p.MinOtherConditions {
+		return fmt.Errorf("other conditions range %d-%d must be non-negative and increasing", p.MinOtherConditions, p.MaxOtherConditions)
+	}
+	for name, percent := range map[string]int{
+		"femalePercent":      p.FemalePercent,
+		"afibPercentUnder65": p.AfibPercentUnder65,
+		"afibPercentOver65":  p.AfibPercentOver65,
+		"householdPercent":   p.HouseholdPercent,
+
+		"lateRefillPercent":      p.LateRefillPercent,
+		"refillGapPercent":       p.RefillGapPercent,
+		"discontinuationPercent": p.DiscontinuationPercent,
+
+		"emergencyVisitPercent":             p.EmergencyVisitPercent,
+		"emergencyVisitPercentPerCondition": p.EmergencyVisitPercentPerCondition,
+		"emergencyAdmissionPercent":         p.EmergencyAdmissionPercent,
+		"readmissionPercent":                p.ReadmissionPercent,
+
+		"mobilePhonePercent": p.MobilePhonePercent,
+		"emailPercent":       p.EmailPercent,
+	} {
+		if percent < 0 || percent > 100 {
+			return fmt.Errorf("%s %d must be between 0 and 100", name, percent)
+		}
+	}
+
+	defaults := DefaultProfile()
+	prevalences := []struct {
+		name    string
+		weights map[string]int
+		known   map[string]int
+	}{
+		{"smoking", p.Smoking, defaults.Smoking},
+		{"alcohol", p.Alcohol, defaults.Alcohol},
+		{"cholesterol", p.Cholesterol, defaults.Cholesterol},
+		{"hypertension", p.Hypertension, defaults.Hypertension},
+		{"diabetes", p.Diabetes, defaults.Diabetes},
+		{"race", p.Race, defaults.Race},
+		{"ethnicity", p.Ethnicity, defaults.Ethnicity},
+		{"language", p.Language, defaults.Language},
+		{"maritalStatus", p.MaritalStatus, defaults.MaritalStatus},
+	}
+	for _, prevalence := range prevalences {
+		sum := 0
+		for state, weight := range prevalence.weights {
+			if _, ok := prevalence.known[state]; !ok {
+				return fmt.Errorf("unknown %s state %q", prevalence.name, state)
+			}
+			if weight < 0 {
+				return fmt.Errorf("%s weight for %q must not be negative", prevalence.name, state)
+			}
+			sum += weight
+		}
+		if sum == 0 {
+			return fmt.Errorf("%s must have at least one state with a positive weight", prevalence.name)
+		}
+	}
+
+	if _, err := PlacesIn(p.Region); err != nil {
+		return err
+	}
+
+	for name, percent := range p.ConditionIncidence {
+		if conditionByName(name, md) == nil {
+			return fmt.Errorf("unknown condition %q", name)
+		}
+		if percent < 0 || percent > 100 {
+			return fmt.Errorf("incidence of %q must be between 0 and 100", name)
+		}
+	}
+	for name, schedule := range p.Immunizations {
+		if _, ok := vaccines[name]; !ok {
+			return fmt.Errorf("unknown vaccine %q", name)
+		}
+		if schedule.MinAge < 0 {
+			return fmt.Errorf("minimum age for %s must not be negative", name)
+		}
+		if schedule.CoveragePercent < 0 || schedule.CoveragePercent > 100 || schedule.RefusalPercent < 0 || schedule.RefusalPercent > 100 {
+			return fmt.Errorf("coverage and refusal of %s must be between 0 and 100", name)
+		}
+	}
+	return nil
+}
+
+// choose selects one of the weighted states.  States are considered in sorted
+// order so that the same random source always makes the same choice.
+func choose(r *rand.Rand, weights map[string]int) string {
+	states := make([]string, 0, len(weights))
+	for state := range weights {
+		states = append(states, state)
+	}
+	sort.Strings(states)
+	choices := make([]randutil.Choice, 0, len(states))
+	for _, state := range states {
+		choices = append(choices, randutil.Choice{Weight: weights[state], Item: state})
+	}
+	return weightedChoice(r, choices).Item.(string)
+}
+
+// Places returns the places within the profile's region
+func (p *Profile) Places() []Place {
+	places, err := PlacesIn(p.Region)
+	if err != nil {
+		panic("Invalid region: " + err.Error())
+	}
+	return places
+}
+
+// sortedIncidence returns the names of the conditions in the profile's
+// condition incidence, in sorted order
+func (p *Profile) sortedIncidence() []string {
+	names := make([]string, 0, len(p.ConditionIncidence))
+	for name := range p.ConditionIncidence {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,64 @@
+package ptgen
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestDefaultProfileIsValid(t *testing.T) {
+	if err := DefaultProfile().Validate(LoadConditions()); err != nil {
+		t.Errorf("Default profile is invalid: %s", err)
+	}
+}
+
+func TestProfileValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(p *Profile)
+		err    string
+	}{
+		{"decreasing ages", func(p *Profile) { p.MinAge, p.MaxAge = 80, 70 }, "age range 80-70"},
+		{"percentage out of range", func(p *Profile) { p.FemalePercent = 120 }, "femalePercent 120"},
+		{"unknown state", func(p *Profile) { p.Smoking = map[string]int{"Vaper": 1} }, `unknown smoking state "Vaper"`},
+		{"negative weight", func(p *Profile) { p.Race = map[string]int{"White": -1} }, "must not be negative"},
+		{"no positive weight", func(p *Profile) { p.Language = map[string]int{"English": 0} }, "at least one state"},
+		{"unknown region", func(p *Profile) { p.Region = []string{"Atlantis"} }, `unknown state or region "Atlantis"`},
+		{"unknown condition", func(p *Profile) { p.ConditionIncidence = map[string]int{"Lycanthropy": 5} }, `unknown condition "Lycanthropy"`},
+		{"unknown vaccine", func(p *Profile) { p.Immunizations["smallpox"] = ImmunizationSchedule{} }, `unknown vaccine "smallpox"`},
+	}
+	for _, test := range tests {
+		p := DefaultProfile()
+		test.modify(p)
+		err := p.Validate(LoadConditions())
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: expected an error containing %q, got %v", test.name, test.err, err)
+		}
+	}
+}
+
+func TestLoadProfileKeepsDefaults(t *testing.T) {
+	f, err := ioutil.TempFile("", "profile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(`{"name": "younger", "minAge": 40, "maxAge": 60, "immunizations": {"zoster": {"minAge": 60}}}`)
+	f.Close()
+
+	p, err := LoadProfile(f.Name(), LoadConditions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaults := DefaultProfile()
+	if p.MinAge != 40 || p.MaxAge != 60 {
+		t.Errorf("Expected ages 40-60, got %d-%d", p.MinAge, p.MaxAge)
+	}
+	if len(p.Smoking) != len(defaults.Smoking) || p.FemalePercent != defaults.FemalePercent {
+		t.Error("Expected settings missing from the file to keep their default values")
+	}
+	if p.Immunizations["zoster"].MinAge != 60 || p.Immunizations["influenza"] != defaults.Immunizations["influenza"] {
+		t.Errorf("Expected the zoster schedule to be replaced and the others kept, got %v", p.Immunizations)
+	}
+}
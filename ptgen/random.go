@@ -0,0 +1,81 @@
+package ptgen
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"sync"
+
+	"github.com/icrowley/fake"
+	"github.com/jmcvetta/randutil"
+)
+
+// NewRand returns a random source seeded with the given seed.  All of the
+// randomness used when generating a patient is drawn from the source passed
+// in, so two sources created with the same seed will generate the same
+// patients.
+func NewRand(seed int64) *rand.Rand {
+	return rand.New(rand.NewSource(seed))
+}
+
+// weightedChoice selects one of the choices using the supplied random source.
+// Weights are relative, as with randutil.WeightedChoice, and weights of 0 are
+// never selected.
+func weightedChoice(r *rand.Rand, choices []randutil.Choice) randutil.Choice {
+	sum := 0
+	for _, c := range choices {
+		sum += c.Weight
+	}
+	n := r.Intn(sum)
+	for _, c := range choices {
+		n -= c.Weight
+		if n < 0 {
+			return c
+		}
+	}
+	return choices[len(choices)-1]
+}
+
+func choiceString(r *rand.Rand, choices []string) string {
+	return choices[r.Intn(len(choices))]
+}
+
+func randomDigits(r *rand.Rand, n int) string {
+	digits := make([]byte, n)
+	for i := range digits {
+		digits[i] = byte('0' + r.Intn(10))
+	}
+	return string(digits)
+}
+
+var (
+	samplesMutex sync.Mutex
+	samplesCache = make(map[string][]string)
+)
+
+// fakeSample draws a random entry from one of the english sample lists that
+// are embedded in the fake package.  The fake package uses its own time-seeded
+// source, so ptgen reads the lists directly in order to draw from r instead.
+func fakeSample(r *rand.Rand, category string) string {
+	return choiceString(r, fakeSamples(category))
+}
+
+func fakeSamples(category string) []string {
+	samplesMutex.Lock()
+	defer samplesMutex.Unlock()
+	if samples, ok := samplesCache[category]; ok {
+		return samples
+	}
+	f, err := fake.FS(false).Open("/data/en/" + category)
+	if err != nil {
+		panic("Can't get the " + category + " sample data")
+	}
+	defer f.Close()
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		panic("Can't read the " + category + " sample data")
+	}
+	samples := strings.Split(strings.TrimSpace(string(data)), "\n")
+	samplesCache[category] = samples
+	return samples
+}
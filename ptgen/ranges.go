@@ -0,0 +1,79 @@
+package ptgen
+
+import "github.com/intervention-engine/fhir/models"
+
+// A referenceRange is the normal range of a measurement, along with the
+// critical (panic) limits beyond which a value needs immediate attention.
+// Limits that don't apply to the measurement are nil.
+type referenceRange struct {
+	Low          *float64
+	High         *float64
+	CriticalLow  *float64
+	CriticalHigh *float64
+}
+
+func limit(v float64) *float64 {
+	return &v
+}
+
+// referenceRanges contains the adult reference range of each measurement,
+// keyed by LOINC code, in the units the measurement is generated in
+var referenceRanges = map[string]referenceRange{
+	"8480-6":  {Low: limit(90), High: limit(120), CriticalLow: limit(70), CriticalHigh: limit(180)},
+	"8462-4":  {Low: limit(60), High: limit(80), CriticalLow: limit(40), CriticalHigh: limit(120)},
+	"1558-6":  {Low: limit(70), High: limit(99), CriticalLow: limit(50), CriticalHigh: limit(400)},
+	"4548-4":  {Low: limit(4), High: limit(5.6)},
+	"13457-7": {High: limit(99)},
+	"2085-9":  {Low: limit(40)},
+	"3043-7":  {High: limit(149), CriticalHigh: limit(1000)},
+}
+
+// interpretations contains the v2 abnormal flag display of each
+// interpretation code
+var interpretations = map[string]string{
+	"LL": "Below lower panic limits",
+	"L":  "Below low normal",
+	"N":  "Normal",
+	"H":  "Above high normal",
+	"HH": "Above upper panic limits",
+}
+
+// interpret attaches the reference range of each observation's measurement to
+// it, and interprets its value against the range as critically low (LL), low
+// (L), normal (N), high (H) or critically high (HH).  Observations without a
+// reference range are unchanged.
+func interpret(obs []models.Observation) {
+	for i := range obs {
+		o := &obs[i]
+		rr, ok := referenceRanges[o.Code.Coding[0].Code]
+		if !ok || o.ValueQuantity == nil {
+			continue
+		}
+		component := models.ObservationReferenceRangeComponent{}
+		if rr.Low != nil {
+			component.Low = newQuantity(*rr.Low, o.ValueQuantity.Unit)
+		}
+		if rr.High != nil {
+			component.High = newQuantity(*rr.High, o.ValueQuantity.Unit)
+		}
+		o.ReferenceRange = []models.ObservationReferenceRangeComponent{component}
+
+		code := rr.interpret(*o.ValueQuantity.Value)
+		o.Interpretation = &models.CodeableConcept{Coding: []models.Coding{{Code: code, System: "http://hl7.org/fhir/v2/0078"}}, Text: interpretations[code]}
+	}
+}
+
+// interpret returns the interpretation code of the value against the range
+func (rr referenceRange) interpret(v float64) string {
+	switch {
+	case rr.CriticalLow != nil && v < *rr.CriticalLow:
+		return "LL"
+	case rr.CriticalHigh != nil && v > *rr.CriticalHigh:
+		return "HH"
+	case rr.Low != nil && v < *rr.Low:
+		return "L"
+	case rr.High != nil && v > *rr.High:
+		return "H"
+	}
+	return "N"
+}
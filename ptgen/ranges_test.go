@@ -0,0 +1,81 @@
+package ptgen
+
+import (
+	"testing"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+func TestInterpretAtTheLimits(t *testing.T) {
+	tests := []struct {
+		code  string
+		value float64
+		flag  string
+	}{
+		// Systolic blood pressure: critical 70 and 180, normal 90 to 120
+		{"8480-6", 69, "LL"},
+		{"8480-6", 70, "L"},
+		{"8480-6", 89, "L"},
+		{"8480-6", 90, "N"},
+		{"8480-6", 120, "N"},
+		{"8480-6", 121, "H"},
+		{"8480-6", 180, "H"},
+		{"8480-6", 181, "HH"},
+		// Fasting glucose: critical 50 and 400, normal 70 to 99
+		{"1558-6", 49, "LL"},
+		{"1558-6", 50, "L"},
+		{"1558-6", 70, "N"},
+		{"1558-6", 99, "N"},
+		{"1558-6", 400, "H"},
+		{"1558-6", 401, "HH"},
+		// Hemoglobin A1c: normal 4 to 5.6, no critical limits
+		{"4548-4", 1, "L"},
+		{"4548-4", 4, "N"},
+		{"4548-4", 5.6, "N"},
+		{"4548-4", 5.7, "H"},
+		{"4548-4", 20, "H"},
+		// LDL: high above 99 only
+		{"13457-7", 0, "N"},
+		{"13457-7", 99, "N"},
+		{"13457-7", 100, "H"},
+		{"13457-7", 500, "H"},
+		// HDL: low below 40 only
+		{"2085-9", 39, "L"},
+		{"2085-9", 40, "N"},
+		{"2085-9", 200, "N"},
+		// Triglycerides: high above 149, critical above 1000
+		{"3043-7", 149, "N"},
+		{"3043-7", 150, "H"},
+		{"3043-7", 1000, "H"},
+		{"3043-7", 1001, "HH"},
+	}
+	for _, test := range tests {
+		obs := []models.Observation{{Code: &models.CodeableConcept{Coding: []models.Coding{{Code: test.code}}}, ValueQuantity: newQuantity(test.value, "mg/dL")}}
+		interpret(obs)
+		if obs[0].Interpretation == nil {
+			t.Errorf("Expected %v of %s to be interpreted", test.value, test.code)
+			continue
+		}
+		if flag := obs[0].Interpretation.Coding[0].Code; flag != test.flag {
+			t.Errorf("Expected %v of %s to be %s, got %s", test.value, test.code, test.flag, flag)
+		}
+	}
+}
+
+func TestInterpretAttachesOnlyTheLimitsThatApply(t *testing.T) {
+	obs := []models.Observation{
+		{Code: &models.CodeableConcept{Coding: []models.Coding{{Code: "13457-7"}}}, ValueQuantity: newQuantity(120, "mg/dL")},
+		{Code: &models.CodeableConcept{Coding: []models.Coding{{Code: "2085-9"}}}, ValueQuantity: newQuantity(50, "mg/dL")},
+		{Code: &models.CodeableConcept{Coding: []models.Coding{{Code: "29463-7"}}}, ValueQuantity: newQuantity(180, "lbs")},
+	}
+	interpret(obs)
+	if rr := obs[0].ReferenceRange; len(rr) != 1 || rr[0].Low != nil || rr[0].High == nil || *rr[0].High.Value != 99 {
+		t.Errorf("Expected LDL to have only a high limit of 99, got %v", rr)
+	}
+	if rr := obs[1].ReferenceRange; len(rr) != 1 || rr[0].High != nil || rr[0].Low == nil || *rr[0].Low.Value != 40 {
+		t.Errorf("Expected HDL to have only a low limit of 40, got %v", rr)
+	}
+	if obs[2].ReferenceRange != nil || obs[2].Interpretation != nil {
+		t.Errorf("Expected a weight to have no reference range or interpretation, got %v and %v", obs[2].ReferenceRange, obs[2].Interpretation)
+	}
+}
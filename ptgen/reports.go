@@ -0,0 +1,60 @@
+package ptgen
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// labPanel describes a LOINC panel and the LOINC codes of the lab results that
+// are reported under it
+type labPanel struct {
+	Code    string
+	Name    string
+	Results []string
+}
+
+var labPanels = []labPanel{
+	{"55399-0", "Diabetes Tracking Panel", []string{"1558-6", "4548-4"}},
+	{"24331-1", "Lipid Panel", []string{"13457-7", "2085-9", "3043-7"}},
+}
+
+// GenerateLabReports groups the lab results among the observations by panel
+// and returns a diagnostic report for each panel with results.  Grouped results
+// are assigned IDs so that the reports can reference them.  Each report is
+// effective on the date of its first result.
+func GenerateLabReports(r *rand.Rand, obs []models.Observation) []models.DiagnosticReport {
+	var reports []models.DiagnosticReport
+	for _, panel := range labPanels {
+		var report *models.DiagnosticReport
+		for i := range obs {
+			if !panel.includes(obs[i].Code) {
+				continue
+			}
+			if report == nil {
+				report = &models.DiagnosticReport{Status: "final"}
+				report.Id = strconv.FormatInt(r.Int63(), 10)
+				report.Category = &models.CodeableConcept{Coding: []models.Coding{{Code: "CH", System: "http://hl7.org/fhir/v2/0074"}}, Text: "Chemistry"}
+				report.Code = &models.CodeableConcept{Coding: []models.Coding{{Code: panel.Code, System: "http://loinc.org"}}, Text: panel.Name}
+				report.EffectiveDateTime = obs[i].EffectiveDateTime
+				report.Issued = &models.FHIRDateTime{Time: obs[i].EffectiveDateTime.Time, Precision: models.Timestamp}
+			}
+			obs[i].Id = strconv.FormatInt(r.Int63(), 10)
+			report.Result = append(report.Result, models.Reference{Reference: "cid:" + obs[i].Id})
+		}
+		if report != nil {
+			reports = append(reports, *report)
+		}
+	}
+	return reports
+}
+
+func (p labPanel) includes(code *models.CodeableConcept) bool {
+	for _, result := range p.Results {
+		if code.MatchesCode("http://loinc.org", result) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+package ptgen
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// A RiskScore is a patient's expected score under one of the risk models, as
+// of a date, with the outcome the model predicts, the points contributed by
+// each of the model's factors, and the IDs of the conditions that contributed
+// them.  Applicable is false if the model doesn't apply to the patient (e.g.,
+// CHA2DS2-VASc for a patient without atrial fibrillation), although the score
+// is still calculated.
+type RiskScore struct {
+	Model      string         `json:"model"`
+	Outcome    string         `json:"outcome"`
+	Date       time.Time      `json:"date"`
+	Score      int            `json:"score"`
+	Applicable bool           `json:"applicable"`
+	Factors    map[string]int `json:"factors"`
+	Basis      []string       `json:"basis"`
+}
+
+// ScoreRisks returns the patient's expected score under each supported risk
+// model, as of the given date, from the patient's context and conditions.
+// Only conditions that began on or before the date are considered.
+func ScoreRisks(ctx Context, gender string, conditions []models.Condition, date time.Time) []RiskScore {
+	return []RiskScore{scoreCHA2DS2VASc(ctx, gender, conditions, date)}
+}
+
+// strokeConditions are the conditions that count as a prior stroke
+var strokeConditions = map[string]bool{
+	"Stroke without Cerebral Infarction": true,
+	"Stroke with Cerebral Infarction":    true,
+}
+
+// scoreCHA2DS2VASc scores the patient's risk of stroke with atrial
+// fibrillation: a point each for congestive heart failure, hypertension,
+// diabetes, vascular disease (atherosclerosis), being female, and being 65 to
+// 74 years old, and two points each for being 75 or older and for a prior
+// stroke.  It applies to patients with atrial fibrillation.
+func scoreCHA2DS2VASc(ctx Context, gender string, conditions []models.Condition, date time.Time) RiskScore {
+	s := RiskScore{Model: "CHA2DS2-VASc", Outcome: "Stroke", Date: date, Factors: make(map[string]int)}
+	addFactor := func(factor string, points int, c *models.Condition) {
+		if _, ok := s.Factors[factor]; ok {
+			return
+		}
+		s.Factors[factor] = points
+		s.Score += points
+		if c != nil {
+			s.Basis = append(s.Basis, c.Id)
+		}
+	}
+	for i := range conditions {
+		c := &conditions[i]
+		if c.OnsetDateTime.Time.After(date) {
+			continue
+		}
+		switch name := c.Code.Text; {
+		case name == "Atrial Fibrillation":
+			s.Applicable = true
+		case name == "Congestive Heart Failure":
+			addFactor("congestiveHeartFailure", 1, c)
+		case name == "Hypertension":
+			addFactor("hypertension", 1, c)
+		case name == "Diabetes":
+			addFactor("diabetes", 1, c)
+		case name == "Athersclerosis":
+			addFactor("vascularDisease", 1, c)
+		case strokeConditions[name]:
+			addFactor("stroke", 2, c)
+		}
+	}
+	switch age := ageOn(ctx.BirthDate, date); {
+	case age >= 75:
+		addFactor("age", 2, nil)
+	case age >= 65:
+		addFactor("age", 1, nil)
+	}
+	if gender == "female" {
+		addFactor("sex", 1, nil)
+	}
+	return s
+}
+
+// riskAssessmentSystem is the system the risk models are coded in as the
+// method of a risk assessment
+const riskAssessmentSystem = "http://interventionengine.org/risk-assessments"
+
+// cha2ds2vascStrokeRates are the adjusted yearly stroke rates, in percent, for
+// each CHA2DS2-VASc score (Lip et al., Chest 2010)
+var cha2ds2vascStrokeRates = []float64{0, 1.3, 2.2, 3.2, 4.0, 6.7, 9.8, 9.6, 6.7, 15.2}
+
+// RiskAssessment returns the score as a risk assessment of the patient.  The
+// model is coded as the assessment's method, the score is given in the
+// prediction's rationale along with the yearly stroke rate it maps to as the
+// prediction's probabilityDecimal, and the conditions that contributed to it
+// are recorded as the assessment's basis.
+func (s RiskScore) RiskAssessment(patientID string) *models.RiskAssessment {
+	ra := &models.RiskAssessment{}
+	ra.Subject = &models.Reference{Reference: "cid:" + patientID}
+	ra.Date = &models.FHIRDateTime{Time: s.Date, Precision: models.Date}
+	ra.Method = &models.CodeableConcept{Coding: []models.Coding{{System: riskAssessmentSystem, Code: s.Model}}, Text: s.Model}
+	for _, id := range s.Basis {
+		ra.Basis = append(ra.Basis, models.Reference{Reference: "cid:" + id})
+	}
+	prediction := models.RiskAssessmentPredictionComponent{
+		Outcome:   &models.CodeableConcept{Text: s.Outcome},
+		Rationale: fmt.Sprintf("%s score of %d", s.Model, s.Score),
+	}
+	if s.Model == "CHA2DS2-VASc" && s.Score < len(cha2ds2vascStrokeRates) {
+		probability := cha2ds2vascStrokeRates[s.Score] / 100
+		prediction.ProbabilityDecimal = &probability
+	}
+	ra.Prediction = []models.RiskAssessmentPredictionComponent{prediction}
+	return ra
+}
@@ -0,0 +1,99 @@
+package ptgen
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+func namedCondition(id, name string, onset time.Time) models.Condition {
+	c := condition(onset)
+	c.Id = id
+	c.Code = &models.CodeableConcept{Text: name}
+	return *c
+}
+
+func TestCHA2DS2VAScCriteria(t *testing.T) {
+	date := day(2018, time.June, 1)
+	onset := day(2010, time.January, 1)
+	young := day(1962, time.June, 2)
+	tests := []struct {
+		name       string
+		birthDate  time.Time
+		gender     string
+		conditions []models.Condition
+		factor     string
+		score      int
+	}{
+		{"no risk factors", young, "male", nil, "", 0},
+		{"congestive heart failure", young, "male", []models.Condition{namedCondition("c", "Congestive Heart Failure", onset)}, "congestiveHeartFailure", 1},
+		{"hypertension", young, "male", []models.Condition{namedCondition("c", "Hypertension", onset)}, "hypertension", 1},
+		{"diabetes", young, "male", []models.Condition{namedCondition("c", "Diabetes", onset)}, "diabetes", 1},
+		{"vascular disease", young, "male", []models.Condition{namedCondition("c", "Athersclerosis", onset)}, "vascularDisease", 1},
+		{"stroke", young, "male", []models.Condition{namedCondition("c", "Stroke with Cerebral Infarction", onset)}, "stroke", 2},
+		{"stroke without infarction", young, "male", []models.Condition{namedCondition("c", "Stroke without Cerebral Infarction", onset)}, "stroke", 2},
+		{"female", young, "female", nil, "sex", 1},
+		{"just under 65", day(1953, time.June, 2), "male", nil, "", 0},
+		{"65", day(1953, time.June, 1), "male", nil, "age", 1},
+		{"74", day(1943, time.June, 2), "male", nil, "age", 1},
+		{"75", day(1943, time.June, 1), "male", nil, "age", 2},
+		{"condition after the date", young, "male", []models.Condition{namedCondition("c", "Hypertension", date.AddDate(0, 0, 1))}, "", 0},
+	}
+	for _, test := range tests {
+		s := scoreCHA2DS2VASc(Context{BirthDate: test.birthDate}, test.gender, test.conditions, date)
+		if s.Score != test.score {
+			t.Errorf("%s: expected a score of %d, got %d", test.name, test.score, s.Score)
+		}
+		if test.factor != "" && s.Factors[test.factor] != test.score {
+			t.Errorf("%s: expected %d points for %s, got %v", test.name, test.score, test.factor, s.Factors)
+		}
+		if test.factor == "" && len(s.Factors) != 0 {
+			t.Errorf("%s: expected no factors, got %v", test.name, s.Factors)
+		}
+		if s.Applicable {
+			t.Errorf("%s: expected the score not to apply without atrial fibrillation", test.name)
+		}
+	}
+}
+
+func TestCHA2DS2VAScCountsEachFactorOnce(t *testing.T) {
+	date := day(2016, time.June, 1)
+	onset := day(2010, time.January, 1)
+	conditions := []models.Condition{
+		namedCondition("afib", "Atrial Fibrillation", onset),
+		namedCondition("htn1", "Hypertension", onset),
+		namedCondition("htn2", "Hypertension", onset),
+		namedCondition("stroke1", "Stroke with Cerebral Infarction", onset),
+		namedCondition("stroke2", "Stroke without Cerebral Infarction", onset),
+	}
+	s := scoreCHA2DS2VASc(Context{BirthDate: day(1930, time.January, 1)}, "female", conditions, date)
+	if !s.Applicable {
+		t.Error("Expected the score to apply with atrial fibrillation")
+	}
+	if s.Score != 6 {
+		t.Errorf("Expected a score of 6, got %d (%v)", s.Score, s.Factors)
+	}
+	if len(s.Basis) != 2 || s.Basis[0] != "htn1" || s.Basis[1] != "stroke1" {
+		t.Errorf("Expected the first hypertension and stroke as the basis, got %v", s.Basis)
+	}
+}
+
+func TestRiskAssessmentMapsTheScoreToAStrokeRate(t *testing.T) {
+	s := RiskScore{Model: "CHA2DS2-VASc", Outcome: "Stroke", Date: day(2016, time.June, 1), Score: 2, Applicable: true, Basis: []string{"htn"}}
+	ra := s.RiskAssessment("pt")
+	if len(ra.Method.Coding) != 1 || ra.Method.Coding[0].System != riskAssessmentSystem || ra.Method.Coding[0].Code != "CHA2DS2-VASc" {
+		t.Errorf("Expected the method to be coded as CHA2DS2-VASc, got %v", ra.Method.Coding)
+	}
+	prediction := ra.Prediction[0]
+	if prediction.ProbabilityDecimal == nil || math.Abs(*prediction.ProbabilityDecimal-0.022) > 1e-9 {
+		t.Errorf("Expected a probability of 0.022, got %v", prediction.ProbabilityDecimal)
+	}
+	if prediction.Rationale != "CHA2DS2-VASc score of 2" {
+		t.Errorf("Expected the score in the rationale, got %q", prediction.Rationale)
+	}
+	if len(ra.Basis) != 1 || ra.Basis[0].Reference != "cid:htn" {
+		t.Errorf("Expected the basis to reference the condition, got %v", ra.Basis)
+	}
+}
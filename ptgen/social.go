@@ -0,0 +1,78 @@
+package ptgen
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// smokingConditions are the conditions that smoking leads to
+var smokingConditions = map[string]bool{
+	"Emphysema":   true,
+	"Lung Cancer": true,
+}
+
+// SmokingQuitDate returns the date an ex-smoker quit smoking.  Patients who
+// developed a condition caused by smoking quit within six months of its onset.
+// Otherwise, they quit between one and twenty years before the as-of date.
+func SmokingQuitDate(r *rand.Rand, conditions []models.Condition, asOf time.Time) time.Time {
+	var firstOnset time.Time
+	for _, c := range conditions {
+		if smokingConditions[c.Code.Text] && (firstOnset.IsZero() || c.OnsetDateTime.Time.Before(firstOnset)) {
+			firstOnset = c.OnsetDateTime.Time
+		}
+	}
+	if !firstOnset.IsZero() {
+		quit := firstOnset.AddDate(0, r.Intn(6), r.Intn(28))
+		if quit.After(asOf) {
+			quit = firstOnset
+		}
+		return quit.Truncate(time.Hour * 24)
+	}
+	return asOf.AddDate(-1-r.Intn(20), -r.Intn(12), -r.Intn(28)).Truncate(time.Hour * 24)
+}
+
+// GenerateSocialHistory returns the patient's smoking status and alcohol use as
+// of the given date.  An ex-smoker who had not yet quit on the date is recorded
+// as a current smoker, and the smoking status of an ex-smoker who had quit
+// includes the date they quit.
+func GenerateSocialHistory(ctx Context, date time.Time) []models.Observation {
+	smoking, alcohol := newObservation(date), newObservation(date)
+	smoking.Category = socialHistoryCategory()
+	alcohol.Category = socialHistoryCategory()
+	smoking.Code = &models.CodeableConcept{Coding: []models.Coding{{Code: "72166-2", System: "http://loinc.org"}}, Text: "Tobacco Smoking Status"}
+	alcohol.Code = &models.CodeableConcept{Coding: []models.Coding{{Code: "11331-6", System: "http://loinc.org"}}, Text: "History of Alcohol Use"}
+
+	switch {
+	case ctx.Smoker == "Smoker" || (ctx.Smoker == "Ex-smoker" && date.Before(ctx.QuitDate)):
+		smoking.ValueCodeableConcept = snomedConcept("449868002", "Current every day smoker")
+	case ctx.Smoker == "Ex-smoker":
+		smoking.ValueCodeableConcept = snomedConcept("8517006", "Former smoker")
+		smoking.Component = []models.ObservationComponentComponent{{
+			Code:          &models.CodeableConcept{Coding: []models.Coding{{Code: "74010-0", System: "http://loinc.org"}}, Text: "Date Quit Tobacco Smoking"},
+			ValueDateTime: &models.FHIRDateTime{Time: ctx.QuitDate, Precision: models.Date},
+		}}
+	default:
+		smoking.ValueCodeableConcept = snomedConcept("266919005", "Never smoker")
+	}
+
+	switch ctx.Alcohol {
+	case "Heavy":
+		alcohol.ValueCodeableConcept = snomedConcept("86933000", "Heavy drinker")
+	case "Occasional":
+		alcohol.ValueCodeableConcept = snomedConcept("228276006", "Occasional drinker")
+	default:
+		alcohol.ValueCodeableConcept = snomedConcept("105542008", "Non-drinker")
+	}
+
+	return []models.Observation{smoking, alcohol}
+}
+
+func socialHistoryCategory() *models.CodeableConcept {
+	return &models.CodeableConcept{Coding: []models.Coding{{Code: "social-history", System: "http://hl7.org/fhir/observation-category"}}, Text: "Social History"}
+}
+
+func snomedConcept(code, display string) *models.CodeableConcept {
+	return &models.CodeableConcept{Coding: []models.Coding{{Code: code, System: "http://snomed.info/sct"}}, Text: display}
+}
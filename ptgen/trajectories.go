@@ -0,0 +1,208 @@
+package ptgen
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// A Trajectory models the value of a vital sign or lab result over time.  The
+// value drifts linearly from its baseline on the anchor date and is shifted by
+// the effects of the patient's conditions and medications.  Each measurement
+// varies randomly by up to Noise in either direction.
+type Trajectory struct {
+	Baseline     float64
+	Anchor       time.Time
+	DriftPerYear float64
+	Noise        float64
+	Effects      []Effect
+}
+
+// An Effect shifts a trajectory by Delta while it is in effect.  Effects phase
+// in over the month after they start and phase out over the month after they
+// end.  A zero End indicates an ongoing effect.
+type Effect struct {
+	Start time.Time
+	End   time.Time
+	Delta float64
+}
+
+const effectPhaseDays = 30
+
+// ValueAt returns a measurement of the trajectory on the given date
+func (t *Trajectory) ValueAt(r *rand.Rand, date time.Time) float64 {
+	years := date.Sub(t.Anchor).Hours() / (24 * 365)
+	v := t.Baseline + t.DriftPerYear*years
+	for _, e := range t.Effects {
+		v += e.deltaAt(date)
+	}
+	return v + (r.Float64()*2-1)*t.Noise
+}
+
+func (e Effect) deltaAt(date time.Time) float64 {
+	if date.Before(e.Start) {
+		return 0
+	}
+	weight := math.Min(date.Sub(e.Start).Hours()/24/effectPhaseDays, 1)
+	if !e.End.IsZero() && date.After(e.End) {
+		weight *= math.Max(1-date.Sub(e.End).Hours()/24/effectPhaseDays, 0)
+	}
+	return e.Delta * weight
+}
+
+// trajectoryDefaults contains the drift per year and noise for each measurement,
+// keyed by LOINC code.  Codes are listed in a fixed order so that baselines are
+// drawn in the same order every time.
+var trajectoryDefaults = []struct {
+	Code         string
+	DriftPerYear float64
+	Noise        float64
+}{
+	{"8480-6", 0.8, 6},
+	{"8462-4", -0.3, 4},
+	{"1558-6", 0.5, 10},
+	{"4548-4", 0.2, 2},
+	{"13457-7", -0.5, 8},
+	{"2085-9", 0, 3},
+	{"3043-7", 0, 15},
+}
+
+// preConditionStates maps the states that lead to a condition to the state the
+// patient was in before the condition's onset, and stateConditions maps them
+// to the condition itself.  The onset of the condition moves the measurement
+// from the band of the earlier state into the band of the later one.
+var preConditionStates = map[string]string{
+	"Hypertension": "Pre-hypertension",
+	"Diabetes":     "Pre-diabetes",
+	"High":         "Borderline",
+	"Very High":    "Borderline",
+}
+
+var stateConditions = map[string]string{
+	"Hypertension": "Hypertension",
+	"Diabetes":     "Diabetes",
+	"High":         "Hyperlipidemia",
+	"Very High":    "Hyperlipidemia",
+}
+
+// measureEffect is the change in the measurement with the given LOINC code
+type measureEffect struct {
+	Code  string
+	Delta float64
+}
+
+// conditionEffects contains the effects of conditions other than those driven
+// by the patient's states, keyed by condition name
+var conditionEffects = map[string][]measureEffect{
+	"Congestive Heart Failure": {{"29463-7", 8}},
+	"Chronic Kidney Disease":   {{"8480-6", 10}, {"8462-4", 5}},
+	"Athersclerosis":           {{"13457-7", 20}},
+	"Hypothyroidism":           {{"13457-7", 15}, {"29463-7", 5}},
+	"Lung Cancer":              {{"29463-7", -15}},
+	"Colon Cancer":             {{"29463-7", -15}},
+	"Liver Cancer":             {{"29463-7", -15}},
+	"Lymphatic Leukemia":       {{"29463-7", -10}},
+}
+
+// medicationEffects contains the effects of medications, keyed by RxNorm code
+var medicationEffects = map[string][]measureEffect{
+	// Lisinopril 5mg and 10mg
+	"104376": {{"8480-6", -10}, {"8462-4", -5}},
+	"104377": {{"8480-6", -18}, {"8462-4", -9}},
+	// Metformin
+	"860998": {{"1558-6", -70}, {"4548-4", -12}},
+	// Atorvastatin
+	"617310": {{"13457-7", -50}, {"2085-9", 4}, {"3043-7", -30}},
+	// Prednisone
+	"198145": {{"1558-6", 25}},
+}
+
+// NewTrajectories returns the trajectories of the patient's vital signs and
+// lab results, keyed by LOINC code.  Baselines are drawn from the bands of the
+// patient's states, and each trajectory responds to the onset and abatement of
+// the patient's conditions and to the medications treating them.  Medications
+// only take effect while the patient has a supply of them: coverage contains
+// the periods covered by the dispenses of each condition's medication (see
+// DispenseCoverage), keyed by condition ID, so refill gaps and early
+// discontinuation show up in the patient's measurements.
+func NewTrajectories(r *rand.Rand, ctx Context, conditions []models.Condition, md []ConditionMetadata, mmd []MedicationMetadata, coverage map[string][]models.Period, deathDate *models.FHIRDateTime) map[string]*Trajectory {
+	ts := make(map[string]*Trajectory)
+	stateTargets := make(map[string]float64)
+	for _, d := range trajectoryDefaults {
+		state := ctx.state(d.Code)
+		t := &Trajectory{Anchor: ctx.AsOf, DriftPerYear: d.DriftPerYear, Noise: d.Noise}
+		if pre, ok := preConditionStates[state]; ok {
+			t.Baseline = bands[d.Code][pre].draw(r)
+			stateTargets[d.Code] = bands[d.Code][state].draw(r)
+		} else {
+			t.Baseline = bands[d.Code][state].draw(r)
+		}
+		ts[d.Code] = t
+	}
+	ts["29463-7"] = &Trajectory{Baseline: float64(ctx.Weight), Anchor: ctx.AsOf, DriftPerYear: r.Float64()*4 - 2, Noise: 2}
+
+	for i := range conditions {
+		c := &conditions[i]
+		if !aliveOn(c.OnsetDateTime.Time, deathDate) {
+			continue
+		}
+		var end time.Time
+		if c.AbatementDateTime != nil {
+			end = c.AbatementDateTime.Time
+		}
+		var effects []measureEffect
+		effects = append(effects, conditionEffects[c.Code.Text]...)
+		for _, d := range trajectoryDefaults {
+			if target, ok := stateTargets[d.Code]; ok && stateConditions[ctx.state(d.Code)] == c.Code.Text {
+				effects = append(effects, measureEffect{d.Code, target - ts[d.Code].Baseline})
+			}
+		}
+		if cmd := conditionByName(c.Code.Text, md); cmd != nil && cmd.MedicationID != 0 {
+			if med := medicationByID(cmd.MedicationID, mmd); med != nil {
+				medEnd := medicationEnd(c, deathDate)
+				for _, covered := range coverage[c.Id] {
+					if medEnd != nil && !covered.Start.Time.Before(medEnd.Time) {
+						continue
+					}
+					coveredEnd := covered.End.Time
+					if medEnd != nil && medEnd.Time.Before(coveredEnd) {
+						coveredEnd = medEnd.Time
+					}
+					for _, me := range medicationEffects[med.RxNormCode] {
+						ts[me.Code].Effects = append(ts[me.Code].Effects, Effect{Start: covered.Start.Time, End: coveredEnd, Delta: me.Delta})
+					}
+				}
+			}
+		}
+		for _, ce := range effects {
+			ts[ce.Code].Effects = append(ts[ce.Code].Effects, Effect{Start: c.OnsetDateTime.Time, End: end, Delta: ce.Delta})
+		}
+	}
+	return ts
+}
+
+// DispenseCoverage returns the periods covered by the dispenses of a
+// medication, in order: each dispense covers its days supply from the day it
+// is handed over, and dispenses whose supplies overlap or run back to back
+// (e.g., an early refill) are merged into one period.  Late refills and gaps
+// leave the days between periods uncovered.
+func DispenseCoverage(dispenses []models.MedicationDispense) []models.Period {
+	var periods []models.Period
+	for _, d := range dispenses {
+		start := d.WhenHandedOver.Time
+		end := start.AddDate(0, 0, int(*d.DaysSupply.Value))
+		if n := len(periods); n > 0 && !start.After(periods[n-1].End.Time) {
+			if end.After(periods[n-1].End.Time) {
+				periods[n-1].End = &models.FHIRDateTime{Time: end, Precision: models.Date}
+			}
+			continue
+		}
+		periods = append(periods, models.Period{
+			Start: &models.FHIRDateTime{Time: start, Precision: models.Date},
+			End:   &models.FHIRDateTime{Time: end, Precision: models.Date},
+		})
+	}
+	return periods
+}
@@ -0,0 +1,66 @@
+package ptgen
+
+import (
+	"testing"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func dispense(handedOver time.Time) models.MedicationDispense {
+	return models.MedicationDispense{
+		WhenHandedOver: &models.FHIRDateTime{Time: handedOver, Precision: models.Date},
+		DaysSupply:     newQuantity(supplyDays, "days"),
+	}
+}
+
+func TestDispenseCoverageMergesOverlappingSupplies(t *testing.T) {
+	start := day(2015, time.January, 1)
+	coverage := DispenseCoverage([]models.MedicationDispense{
+		dispense(start),
+		dispense(start.AddDate(0, 0, supplyDays-5)), // early refill
+		dispense(start.AddDate(0, 0, 2*supplyDays-5)),
+		dispense(start.AddDate(0, 0, 5*supplyDays)), // after a gap
+	})
+	if len(coverage) != 2 {
+		t.Fatalf("Expected two covered periods, got %d", len(coverage))
+	}
+	if end := coverage[0].End.Time; !end.Equal(start.AddDate(0, 0, 3*supplyDays-5)) {
+		t.Errorf("Expected the first period to end when the third supply runs out, got %s", end)
+	}
+	if begin := coverage[1].Start.Time; !begin.Equal(start.AddDate(0, 0, 5*supplyDays)) {
+		t.Errorf("Expected the second period to start after the gap, got %s", begin)
+	}
+}
+
+func TestMedicationEffectsFollowCoverage(t *testing.T) {
+	onset := day(2014, time.January, 1)
+	c := models.Condition{Code: &models.CodeableConcept{Text: "Hypertension"}, OnsetDateTime: &models.FHIRDateTime{Time: onset}}
+	c.Id = "1"
+	ctx := Context{Hypertention: "Hypertension", Cholesterol: "Optimal", Diabetes: "Normal", Weight: 180, AsOf: day(2016, time.January, 1)}
+	coverage := map[string][]models.Period{"1": {{
+		Start: &models.FHIRDateTime{Time: onset},
+		End:   &models.FHIRDateTime{Time: onset.AddDate(0, 6, 0)},
+	}}}
+	ts := NewTrajectories(NewRand(1), ctx, []models.Condition{c}, LoadConditions(), LoadMedications(), coverage, nil)
+
+	systolic := ts["8480-6"]
+	medicated := day(2014, time.April, 1)
+	stopped := day(2015, time.January, 1)
+	delta := func(date time.Time) float64 {
+		sum := 0.0
+		for _, e := range systolic.Effects {
+			sum += e.deltaAt(date)
+		}
+		return sum
+	}
+	// The condition's own effect is the same on both dates, so the difference
+	// is the medication's
+	if diff := delta(stopped) - delta(medicated); diff != 18 {
+		t.Errorf("Expected the lisinopril effect (-18) to wear off after the supply ran out, got a difference of %v", diff)
+	}
+}
@@ -0,0 +1,106 @@
+package ptgen
+
+import (
+	"math"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+const ucumSystem = "http://unitsofmeasure.org"
+
+// ucumCodes contains the UCUM code of each unit quantities are generated in,
+// keyed by the unit displayed
+var ucumCodes = map[string]string{
+	"mmHg":   "mm[Hg]",
+	"mg/dL":  "mg/dL",
+	"mmol/L": "mmol/L",
+	"%":      "%",
+	"lbs":    "[lb_av]",
+	"in":     "[in_i]",
+	"kg":     "kg",
+	"cm":     "cm",
+	"days":   "d",
+	"years":  "a",
+	"mg":     "mg",
+	"g":      "g",
+	"mL":     "mL",
+	"drop":   "[drp]",
+	"drops":  "[drp]",
+}
+
+const (
+	kilogramsPerPound  = 0.45359237
+	centimetersPerInch = 2.54
+)
+
+// mmolPerLiterPerMgPerDeciliter contains the factor converting each lab
+// result from mg/dL to mmol/L, keyed by LOINC code
+var mmolPerLiterPerMgPerDeciliter = map[string]float64{
+	"1558-6":  0.0555,  // glucose
+	"13457-7": 0.02586, // LDL cholesterol
+	"2085-9":  0.02586, // HDL cholesterol
+	"3043-7":  0.01129, // triglycerides
+}
+
+// newQuantity returns a quantity of the given value in the given unit
+func newQuantity(value float64, unit string) *models.Quantity {
+	q := &models.Quantity{Value: &value}
+	setUnit(q, unit)
+	return q
+}
+
+// setUnit sets the quantity's unit, along with its UCUM code if the unit has
+// one
+func setUnit(q *models.Quantity, unit string) {
+	q.Unit = unit
+	if code, ok := ucumCodes[unit]; ok {
+		q.System = ucumSystem
+		q.Code = code
+	}
+}
+
+// toMetric converts a quantity of the measurement with the given LOINC code
+// from pounds, inches or mg/dL to kilograms, centimeters or mmol/L.  Weights
+// and heights are rounded to a tenth, and lab results to a hundredth.
+// Quantities in other units, and lab results without a conversion factor, are
+// unchanged.
+func toMetric(code string, q *models.Quantity) {
+	var factor float64
+	var unit string
+	precision := 10.0
+	switch q.Unit {
+	case "lbs":
+		factor, unit = kilogramsPerPound, "kg"
+	case "in":
+		factor, unit = centimetersPerInch, "cm"
+	case "mg/dL":
+		factor, unit, precision = mmolPerLiterPerMgPerDeciliter[code], "mmol/L", 100
+	}
+	if factor == 0 {
+		return
+	}
+	value := math.Floor(*q.Value*factor*precision+0.5) / precision
+	q.Value = &value
+	setUnit(q, unit)
+}
+
+// observationsToMetric converts the values and reference ranges of the
+// observations to metric units.  Observations should be interpreted first,
+// since their interpretation is computed from the units they are generated
+// in.
+func observationsToMetric(obs []models.Observation) {
+	for i := range obs {
+		code := obs[i].Code.Coding[0].Code
+		if obs[i].ValueQuantity != nil {
+			toMetric(code, obs[i].ValueQuantity)
+		}
+		for _, rr := range obs[i].ReferenceRange {
+			if rr.Low != nil {
+				toMetric(code, rr.Low)
+			}
+			if rr.High != nil {
+				toMetric(code, rr.High)
+			}
+		}
+	}
+}
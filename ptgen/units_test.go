@@ -0,0 +1,53 @@
+package ptgen
+
+import (
+	"math"
+	"testing"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+func TestToMetric(t *testing.T) {
+	tests := []struct {
+		code, unit  string
+		value       float64
+		metricUnit  string
+		metricCode  string
+		metricValue float64
+	}{
+		{"29463-7", "lbs", 180, "kg", "kg", 81.6},
+		{"29463-7", "lbs", 1, "kg", "kg", 0.5},
+		{"8302-2", "in", 70, "cm", "cm", 177.8},
+		{"1558-6", "mg/dL", 100, "mmol/L", "mmol/L", 5.55},
+		{"13457-7", "mg/dL", 130, "mmol/L", "mmol/L", 3.36},
+		{"2085-9", "mg/dL", 40, "mmol/L", "mmol/L", 1.03},
+		{"3043-7", "mg/dL", 150, "mmol/L", "mmol/L", 1.69},
+		{"4548-4", "%", 6.5, "%", "%", 6.5},
+		{"8480-6", "mmHg", 120, "mmHg", "mm[Hg]", 120},
+		{"99999-9", "mg/dL", 100, "mg/dL", "mg/dL", 100},
+	}
+	for _, test := range tests {
+		q := newQuantity(test.value, test.unit)
+		toMetric(test.code, q)
+		if math.Abs(*q.Value-test.metricValue) > 1e-9 {
+			t.Errorf("Expected %v %s of %s to be %v %s, got %v", test.value, test.unit, test.code, test.metricValue, test.metricUnit, *q.Value)
+		}
+		if q.Unit != test.metricUnit || q.Code != test.metricCode || q.System != ucumSystem {
+			t.Errorf("Expected %s of %s to be converted to %s (UCUM %s), got %s (%s %s)", test.unit, test.code, test.metricUnit, test.metricCode, q.Unit, q.System, q.Code)
+		}
+	}
+}
+
+func TestObservationsToMetricConvertsReferenceRanges(t *testing.T) {
+	gluc := models.Observation{Code: &models.CodeableConcept{Coding: []models.Coding{{Code: "1558-6"}}}, ValueQuantity: newQuantity(120, "mg/dL")}
+	obs := []models.Observation{gluc}
+	interpret(obs)
+	observationsToMetric(obs)
+	if code := obs[0].Interpretation.Coding[0].Code; code != "H" {
+		t.Errorf("Expected the interpretation from mg/dL to be kept, got %s", code)
+	}
+	rr := obs[0].ReferenceRange[0]
+	if rr.Low.Unit != "mmol/L" || *rr.Low.Value != 3.89 || rr.High.Unit != "mmol/L" || *rr.High.Value != 5.49 {
+		t.Errorf("Expected the reference range in mmol/L, got %v %s to %v %s", *rr.Low.Value, rr.Low.Unit, *rr.High.Value, rr.High.Unit)
+	}
+}
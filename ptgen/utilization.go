@@ -0,0 +1,116 @@
+package ptgen
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// utilizationYears is the number of past years emergency visits are
+// generated for
+const utilizationYears = 3
+
+// An EmergencyVisit is a visit to the emergency department, the condition the
+// patient presented with (if any), and the inpatient stay the patient was
+// admitted to from the emergency department (if any)
+type EmergencyVisit struct {
+	Encounter *models.Encounter
+	Condition *models.Condition
+	Admission *models.Encounter
+}
+
+// GenerateEmergencyVisits returns the patient's emergency department visits
+// over the three years before the as-of date.  Each month, the chance of a
+// visit is the profile's yearly emergency visit percentage, plus its
+// percentage per condition for each of the patient's active conditions, spread
+// over the year.  The patient presents with one of the active conditions, if
+// there are any, and a percentage of visits set by the profile are followed by
+// an admission of one to five nights.
+func GenerateEmergencyVisits(r *rand.Rand, conditions []models.Condition, deathDate *models.FHIRDateTime, p *Profile, asOf time.Time) []EmergencyVisit {
+	var visits []EmergencyVisit
+	start := asOf.AddDate(-utilizationYears, 0, 0).Truncate(time.Hour * 24)
+	for month := start; month.Before(asOf); month = month.AddDate(0, 1, 0) {
+		date := month.AddDate(0, 0, r.Intn(28))
+		if date.After(asOf) || !aliveOn(date, deathDate) {
+			continue
+		}
+		active := activeConditions(conditions, date)
+		rate := p.EmergencyVisitPercent + p.EmergencyVisitPercentPerCondition*len(active)
+		emergencyDiceRoll := r.Intn(12 * 100)
+		if emergencyDiceRoll >= rate {
+			continue
+		}
+
+		visit := EmergencyVisit{}
+		if len(active) > 0 {
+			visit.Condition = active[r.Intn(len(active))]
+		}
+		visit.Encounter = newEmergencyVisit(r, date, visit.Condition)
+		admissionDiceRoll := r.Intn(100)
+		if admissionDiceRoll < p.EmergencyAdmissionPercent {
+			visit.Admission = newInpatientStay(r, date, 1+r.Intn(5), visit.Condition, deathDate, asOf)
+			visit.Admission.Hospitalization.AdmitSource = admitSource("emd", "From accident/emergency department")
+		}
+		visits = append(visits, visit)
+	}
+	return visits
+}
+
+// activeConditions returns the conditions that had begun and not yet abated
+// on the given date
+func activeConditions(conditions []models.Condition, date time.Time) []*models.Condition {
+	var active []*models.Condition
+	for i := range conditions {
+		c := &conditions[i]
+		if c.OnsetDateTime.Time.After(date) || (c.AbatementDateTime != nil && !c.AbatementDateTime.Time.After(date)) {
+			continue
+		}
+		active = append(active, c)
+	}
+	return active
+}
+
+func newEmergencyVisit(r *rand.Rand, date time.Time, c *models.Condition) *models.Encounter {
+	e := &models.Encounter{Status: "finished", Class: "emergency"}
+	e.Id = strconv.FormatInt(r.Int63(), 10)
+	e.Type = []models.CodeableConcept{{Coding: []models.Coding{{Code: "99284", System: "http://www.ama-assn.org/go/cpt"}}, Text: "Emergency Department Visit"}}
+	e.Period = &models.Period{
+		Start: &models.FHIRDateTime{Time: date, Precision: models.Date},
+		End:   &models.FHIRDateTime{Time: date, Precision: models.Date},
+	}
+	if c != nil {
+		e.Reason = []models.CodeableConcept{*c.Code}
+		e.Indication = []models.Reference{{Reference: "cid:" + c.Id}}
+	}
+	return e
+}
+
+// GenerateReadmission returns the readmission following the inpatient stay,
+// or nil if the patient isn't readmitted.  The profile's readmission
+// percentage of the patients discharged home are readmitted through the
+// emergency department within 30 days of discharge, for the same condition
+// (if any), and stay for one to five nights.  No readmission is after the
+// as-of date.
+func GenerateReadmission(r *rand.Rand, stay *models.Encounter, c *models.Condition, deathDate *models.FHIRDateTime, p *Profile, asOf time.Time) *models.Encounter {
+	if stay.Period.End == nil || stay.Hospitalization.DischargeDisposition.Coding[0].Code != "home" {
+		return nil
+	}
+	readmissionDiceRoll := r.Intn(100)
+	if readmissionDiceRoll >= p.ReadmissionPercent {
+		return nil
+	}
+	admit := stay.Period.End.Time.AddDate(0, 0, 1+r.Intn(30))
+	if admit.After(asOf) || !aliveOn(admit, deathDate) {
+		return nil
+	}
+	readmission := newInpatientStay(r, admit, 1+r.Intn(5), c, deathDate, asOf)
+	readmission.Hospitalization.AdmitSource = admitSource("emd", "From accident/emergency department")
+	readmission.Hospitalization.ReAdmission = &models.CodeableConcept{Coding: []models.Coding{{Code: "R", System: "http://hl7.org/fhir/v2/0092"}}, Text: "Re-admission"}
+	return readmission
+}
+
+func admitSource(code, display string) *models.CodeableConcept {
+	return &models.CodeableConcept{Coding: []models.Coding{{Code: code, System: "http://hl7.org/fhir/admit-source"}}, Text: display}
+}
@@ -0,0 +1,174 @@
+// Package upload uploads generated FHIR resources to a FHIR server one at a
+// time, replacing the references between them with references to the IDs the
+// server assigns.  Unlike github.com/intervention-engine/fhir/upload, it
+// replaces references nested anywhere in a resource (e.g., a practitioner's
+// roles or an encounter's admitting diagnoses), not just top-level ones.
+package upload
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// UploadResources uploads the resources to the FHIR server at baseURL, each
+// after the resources it references, and sets each resource's ID to the ID
+// the server assigned it.  Before a resource is uploaded, its references are
+// replaced using refs, which maps each uploaded resource's original "cid:"
+// and "<type>/<id>" references to its reference on the server, and which is
+// updated as resources are uploaded.  Passing the same refs to later calls
+// lets their resources reference the resources uploaded before them.  A "cid:"
+// reference that can't be replaced is an error; other references that can't
+// be replaced (e.g., to resources already on the server) are left as they
+// are.
+func UploadResources(resources []interface{}, baseURL string, refs map[string]string) error {
+	// Resources are uploaded in passes, each uploading the resources whose
+	// references to the others have all been uploaded
+	pending := make(map[string]bool)
+	for _, resource := range resources {
+		for _, key := range referenceKeys(resource) {
+			pending[key] = true
+		}
+	}
+	for len(resources) > 0 {
+		var waiting []interface{}
+		for _, resource := range resources {
+			if referencesAny(resource, pending) {
+				waiting = append(waiting, resource)
+				continue
+			}
+			keys := referenceKeys(resource)
+			if err := replaceReferences(resource, refs); err != nil {
+				return err
+			}
+			if err := uploadResource(resource, baseURL); err != nil {
+				return err
+			}
+			ref := resourceType(resource) + "/" + resourceID(resource)
+			for _, key := range keys {
+				refs[key] = ref
+				delete(pending, key)
+			}
+		}
+		if len(waiting) == len(resources) {
+			return fmt.Errorf("Couldn't upload %d resources with circular references", len(waiting))
+		}
+		resources = waiting
+	}
+	return nil
+}
+
+// uploadResource posts the resource to the FHIR server and sets its ID to the
+// ID in the location the server returns.  A response that isn't successful is
+// an error, including the start of the response body (usually an
+// OperationOutcome explaining the failure).
+func uploadResource(resource interface{}, baseURL string) error {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+	rType := resourceType(resource)
+	response, err := http.Post(baseURL+"/"+rType, "application/json+fhir", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(io.LimitReader(response.Body, 512))
+		return fmt.Errorf("Couldn't upload %s: server responded %s: %s", rType, response.Status, bytes.TrimSpace(body))
+	}
+
+	loc := response.Header.Get("Location")
+	if matches := locationID.FindStringSubmatch(loc); matches != nil {
+		reflect.ValueOf(resource).Elem().FieldByName("Id").SetString(matches[1])
+		return nil
+	}
+	return fmt.Errorf("Couldn't find the ID of the uploaded %s in the location %q", rType, loc)
+}
+
+// locationID matches the resource ID in a location, with or without a version
+var locationID = regexp.MustCompile(`([^/]+)(/_history/[^/]+)?$`)
+
+// replaceReferences replaces each reference in the resource that has been
+// uploaded with its reference on the server
+func replaceReferences(resource interface{}, refs map[string]string) error {
+	var err error
+	WalkReferences(resource, func(ref *models.Reference) {
+		if newRef, ok := refs[ref.Reference]; ok {
+			ref.Reference = newRef
+		} else if strings.HasPrefix(ref.Reference, "cid:") && err == nil {
+			err = fmt.Errorf("Failed to find updated reference for %s in %s", ref.Reference, resourceType(resource))
+		}
+	})
+	return err
+}
+
+// referencesAny returns whether the resource references any of the keys
+func referencesAny(resource interface{}, keys map[string]bool) bool {
+	found := false
+	WalkReferences(resource, func(ref *models.Reference) {
+		found = found || keys[ref.Reference]
+	})
+	return found
+}
+
+// referenceKeys returns the references the resource may be referenced by
+// before it is uploaded: a "cid:" reference to its ID, as generated patients'
+// resources reference each other, and a "<type>/<id>" reference, as resources
+// written to disk by generate reference each other
+func referenceKeys(resource interface{}) []string {
+	id := resourceID(resource)
+	if id == "" {
+		return nil
+	}
+	return []string{"cid:" + id, resourceType(resource) + "/" + id}
+}
+
+var referenceType = reflect.TypeOf(models.Reference{})
+
+// WalkReferences calls fn with each reference in the resource, however deeply
+// it is nested
+func WalkReferences(resource interface{}, fn func(*models.Reference)) {
+	walkReferences(reflect.ValueOf(resource), fn)
+}
+
+func walkReferences(v reflect.Value, fn func(*models.Reference)) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			walkReferences(v.Elem(), fn)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			walkReferences(v.Index(i), fn)
+		}
+	case reflect.Struct:
+		if v.Type() == referenceType {
+			if v.CanAddr() {
+				fn(v.Addr().Interface().(*models.Reference))
+			}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				walkReferences(v.Field(i), fn)
+			}
+		}
+	}
+}
+
+func resourceID(resource interface{}) string {
+	return reflect.ValueOf(resource).Elem().FieldByName("Id").String()
+}
+
+func resourceType(resource interface{}) string {
+	return reflect.TypeOf(resource).Elem().Name()
+}
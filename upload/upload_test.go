@@ -0,0 +1,153 @@
+package upload
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/intervention-engine/fhir/models"
+)
+
+// fakeServer is a FHIR server that assigns each posted resource the next ID
+// and records the resources posted to it
+type fakeServer struct {
+	*httptest.Server
+	mutex  sync.Mutex
+	posted []map[string]interface{}
+}
+
+func newFakeServer() *fakeServer {
+	s := &fakeServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resource map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&resource); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.mutex.Lock()
+		s.posted = append(s.posted, resource)
+		id := len(s.posted)
+		s.mutex.Unlock()
+		w.Header().Set("Location", fmt.Sprintf("%s%s/server%d/_history/1", s.URL, r.URL.Path, id))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	return s
+}
+
+// references returns every reference in the posted resources
+func (s *fakeServer) references() []string {
+	var refs []string
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for k, e := range v {
+				if ref, ok := e.(string); ok && k == "reference" {
+					refs = append(refs, ref)
+				}
+				walk(e)
+			}
+		case []interface{}:
+			for _, e := range v {
+				walk(e)
+			}
+		}
+	}
+	for _, resource := range s.posted {
+		walk(resource)
+	}
+	return refs
+}
+
+func TestUploadResourcesReplacesNestedReferences(t *testing.T) {
+	s := newFakeServer()
+	defer s.Close()
+
+	// The practitioner comes first, but references the organization and
+	// location through its role
+	org := &models.Organization{Name: "Clinic"}
+	org.Id = "1"
+	loc := &models.Location{ManagingOrganization: &models.Reference{Reference: "cid:1"}}
+	loc.Id = "2"
+	pr := &models.Practitioner{PractitionerRole: []models.PractitionerPractitionerRoleComponent{{
+		ManagingOrganization: &models.Reference{Reference: "cid:1"},
+		Location:             []models.Reference{{Reference: "cid:2"}},
+	}}}
+	pr.Id = "3"
+
+	refs := make(map[string]string)
+	if err := UploadResources([]interface{}{pr, org, loc}, s.URL, refs); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.posted) != 3 || s.posted[2]["resourceType"] != "Practitioner" {
+		t.Fatalf("Expected the practitioner to be posted last, got %v", s.posted)
+	}
+	for _, ref := range s.references() {
+		if strings.HasPrefix(ref, "cid:") {
+			t.Errorf("Posted resources still reference %s", ref)
+		}
+	}
+	if pr.PractitionerRole[0].ManagingOrganization.Reference != "Organization/"+org.Id || org.Id != "server1" {
+		t.Errorf("Expected the role to reference Organization/server1, got %s", pr.PractitionerRole[0].ManagingOrganization.Reference)
+	}
+	if refs["Location/2"] != "Location/"+loc.Id {
+		t.Errorf("Expected the uploaded location to be recorded, got %v", refs)
+	}
+}
+
+func TestUploadResourcesReportsMissingReferences(t *testing.T) {
+	s := newFakeServer()
+	defer s.Close()
+
+	c := &models.Condition{Patient: &models.Reference{Reference: "cid:404"}}
+	if err := UploadResources([]interface{}{c}, s.URL, make(map[string]string)); err == nil {
+		t.Error("Expected an error for the reference to a resource that wasn't uploaded")
+	}
+}
+
+func TestUploadResourcesOrdersForwardReferences(t *testing.T) {
+	s := newFakeServer()
+	defer s.Close()
+
+	// An emergency admission comes before the condition it was admitted for
+	pt := &models.Patient{}
+	pt.Id = "1"
+	stay := &models.Encounter{
+		Patient:         &models.Reference{Reference: "cid:1"},
+		Hospitalization: &models.EncounterHospitalizationComponent{AdmittingDiagnosis: []models.Reference{{Reference: "cid:3"}}},
+	}
+	stay.Id = "2"
+	c := &models.Condition{Patient: &models.Reference{Reference: "cid:1"}}
+	c.Id = "3"
+
+	if err := UploadResources([]interface{}{pt, stay, c}, s.URL, make(map[string]string)); err != nil {
+		t.Fatal(err)
+	}
+	if ref := stay.Hospitalization.AdmittingDiagnosis[0].Reference; ref != "Condition/"+c.Id {
+		t.Errorf("Expected the admitting diagnosis to reference Condition/%s, got %s", c.Id, ref)
+	}
+	for _, ref := range s.references() {
+		if strings.HasPrefix(ref, "cid:") {
+			t.Errorf("Posted resources still reference %s", ref)
+		}
+	}
+}
+
+func TestUploadResourcesReportsFailedResponses(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "http://example.com/Patient/1/_history/1")
+		http.Error(w, `{"resourceType": "OperationOutcome"}`, http.StatusUnprocessableEntity)
+	}))
+	defer s.Close()
+
+	pt := &models.Patient{}
+	pt.Id = "1"
+	err := UploadResources([]interface{}{pt}, s.URL, make(map[string]string))
+	if err == nil || !strings.Contains(err.Error(), "422") || !strings.Contains(err.Error(), "OperationOutcome") {
+		t.Errorf("Expected an error with the status and body of the response, got %v", err)
+	}
+}